@@ -4,14 +4,16 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
-	"time"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/nofeaturesonlybugs/sqlh/grammar"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/generator"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
 
 func BenchmarkLibpqSelect(b *testing.B) {
-	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq()
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -33,17 +35,634 @@ func BenchmarkLibpqSelect(b *testing.B) {
 		500,
 		1000,
 	}
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, limit := range limits {
-		b.Run(fmt.Sprintf("database/sql %v rows", limit), sqlhbenchmarks.StandardSelect(limit, db))
-		b.Run(fmt.Sprintf("GORM %v rows", limit), sqlhbenchmarks.GORMSelect(limit, gb))
-		b.Run(fmt.Sprintf("sqlx %v rows", limit), sqlhbenchmarks.SqlxSelect(limit, db))
-		b.Run(fmt.Sprintf("scany %v rows", limit), sqlhbenchmarks.ScanySelect(limit, db))
-		b.Run(fmt.Sprintf("sqlh %v rows", limit), sqlhbenchmarks.SqlhSelect(limit, db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelect(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelect(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelect(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelect(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelect(limit, db)},
+		})
 	}
 }
 
+func BenchmarkLibpqSelectContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectContext(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelectContext(limit, gb)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectContext(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectContext(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectContext(limit, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelSelectContext(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqPreparedSelect(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardPreparedSelect(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMPreparedSelect(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxPreparedSelect(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanyPreparedSelect(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhPreparedSelect(limit, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelPreparedSelect(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqPreparedSelectContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardPreparedSelectContext(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMPreparedSelectContext(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxPreparedSelectContext(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanyPreparedSelectContext(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhPreparedSelectContext(limit, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelPreparedSelectContext(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqSelectIn(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	ids := make([]int, len(addresses))
+	for k, address := range addresses {
+		ids[k] = address.Id
+	}
+	//
+	sizes := []int{
+		1,
+		10,
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, size := range sizes {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v ids", size), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectIn(ids[0:size], sqlhbenchmarks.Postgres, db)},
+			{Name: "database/sql any", Test: sqlhbenchmarks.StandardSelectAny(ids[0:size], db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelectIn(ids[0:size], gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectIn(ids[0:size], sqlhbenchmarks.Postgres, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelSelectIn(ids[0:size], sqlhbenchmarks.Postgres, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqSelectInContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	ids := make([]int, len(addresses))
+	for k, address := range addresses {
+		ids[k] = address.Id
+	}
+	//
+	sizes := []int{
+		1,
+		10,
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, size := range sizes {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v ids", size), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectInContext(ids[0:size], sqlhbenchmarks.Postgres, db)},
+			{Name: "database/sql any", Test: sqlhbenchmarks.StandardSelectAnyContext(ids[0:size], db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelectInContext(ids[0:size], gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectInContext(ids[0:size], sqlhbenchmarks.Postgres, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelSelectInContext(ids[0:size], sqlhbenchmarks.Postgres, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqSelectGenerated(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	_, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		10000,
+		100000,
+		1000000,
+	}
+	addresses := generator.New(generator.DefaultConfig).Addresses(limits[len(limits)-1])
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelect(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelect(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelect(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelect(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelect(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqSelectStream(b *testing.B) {
+	skip, db, _, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	_, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		100000,
+		1000000,
+	}
+	addresses := generator.New(generator.DefaultConfig).Addresses(limits[len(limits)-1])
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	addresses = nil // Not part of the measured heap.
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectStream(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectStream(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectStream(limit, db)},
+			{Name: "set.BoundMapping", Test: sqlhbenchmarks.SetBoundSelectStream(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqCancel(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	_, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limit := 100000
+	addresses := generator.New(generator.DefaultConfig).Addresses(limit)
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	addresses = nil
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v of %v rows", sqlhbenchmarks.CancelAfterRows, limit), sqlhbenchmarks.Contenders{
+		{Name: "database/sql", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.StandardCancelSelector(db), true, limit, db)},
+		{Name: "GORM", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.GORMCancelSelector(gb), true, limit, db)},
+		{Name: "scany", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.ScanyCancelSelector(db), true, limit, db)},
+		{Name: "sqlh", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.SqlhCancelSelector(db), false, limit, db)},
+		{Name: "sqlx", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.SqlxCancelSelector(db), true, limit, db)},
+		{Name: "squirrel", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.SquirrelCancelSelector(db), true, limit, db)},
+	})
+}
+
+func BenchmarkLibpqSelectSales(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.SalesModels...)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	sqlhbenchmarks.SeedTables(b, func() error {
+		return sqlhbenchmarks.SeedSales(types.SaleRecords(), sqlhbenchmarks.Postgres, db)
+	})
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectSales(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelectSales(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectSales(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectSales(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectSales(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqSelectNullable(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelNullableAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	kinds := []string{
+		types.NullablePtr,
+		types.NullableSql,
+	}
+	limits := []int{
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, ratio := range sqlhbenchmarks.NullRatios(b) {
+		seed := func() error {
+			return sqlhbenchmarks.SeedNullableAddresses(limits[len(limits)-1], ratio, sqlhbenchmarks.Postgres, db)
+		}
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, seed, sqlhbenchmarks.ModelNullableAddress)
+		for _, kind := range kinds {
+			for _, limit := range limits {
+				sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v%% null %v rows", kind, sqlhbenchmarks.NullPercent(ratio), limit), sqlhbenchmarks.Contenders{
+					{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectNullable(kind, limit, db)},
+					{Name: "GORM", Test: sqlhbenchmarks.GORMSelectNullable(kind, limit, gb)},
+					{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectNullable(kind, limit, db)},
+					{Name: "scany", Test: sqlhbenchmarks.ScanySelectNullable(kind, limit, db)},
+					{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectNullable(kind, limit, db)},
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkLibpqSelectKitchenSink(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelKitchenSink)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	sqlhbenchmarks.SeedTables(b, func() error {
+		return sqlhbenchmarks.SeedKitchenSinks(limits[len(limits)-1], sqlhbenchmarks.Postgres, db)
+	})
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectKitchenSink(limit, db)},
+			{Name: "GORM", Test: sqlhbenchmarks.GORMSelectKitchenSink(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectKitchenSink(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectKitchenSink(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectKitchenSink(limit, db)},
+		})
+	}
+}
+
+func BenchmarkLibpqSelectMapper(b *testing.B) {
+	skip, db, _, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		contenders := sqlhbenchmarks.Contenders{}
+		for _, config := range sqlhbenchmarks.MapperConfigs {
+			contenders = append(contenders,
+				sqlhbenchmarks.Contender{Name: fmt.Sprintf("sqlh %v shared mapper", config.Name), Test: sqlhbenchmarks.SqlhSelectMapper(config, true, limit, db)},
+				sqlhbenchmarks.Contender{Name: fmt.Sprintf("sqlh %v fresh mapper", config.Name), Test: sqlhbenchmarks.SqlhSelectMapper(config, false, limit, db)},
+			)
+		}
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), contenders)
+	}
+}
+
+func BenchmarkLibpqColdStart(b *testing.B) {
+	skip, db, _, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		1,
+		100,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("cold start %v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "sqlx", Test: sqlhbenchmarks.ColdStart(sqlhbenchmarks.SqlxColdSelector(sqlhbenchmarks.Postgres, db), limit)},
+			{Name: "scany", Test: sqlhbenchmarks.ColdStart(sqlhbenchmarks.ScanyColdSelector(db), limit)},
+			{Name: "sqlh", Test: sqlhbenchmarks.ColdStart(sqlhbenchmarks.SqlhColdSelector(b, db), limit)},
+		})
+	}
+}
+
+func BenchmarkLibpqLookup(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	ids := make([]int, len(addresses))
+	for k, address := range addresses {
+		ids[k] = address.Id
+	}
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	sqlhbenchmarks.RunContenders(b, order, "", sqlhbenchmarks.Contenders{
+		{Name: "database/sql", Test: sqlhbenchmarks.StandardLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "database/sql prepared", Test: sqlhbenchmarks.StandardPreparedLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "GORM", Test: sqlhbenchmarks.GORMLookup(ids, false, gb)},
+		{Name: "GORM prepared", Test: sqlhbenchmarks.GORMLookup(ids, true, gb)},
+		{Name: "sqlx", Test: sqlhbenchmarks.SqlxLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "sqlx prepared", Test: sqlhbenchmarks.SqlxPreparedLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "scany", Test: sqlhbenchmarks.ScanyLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "scany prepared", Test: sqlhbenchmarks.ScanyPreparedLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "sqlh", Test: sqlhbenchmarks.SqlhLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "sqlh prepared", Test: sqlhbenchmarks.SqlhPreparedLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "squirrel", Test: sqlhbenchmarks.SquirrelLookup(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "squirrel prepared", Test: sqlhbenchmarks.SquirrelPreparedLookup(ids, sqlhbenchmarks.Postgres, db)},
+	})
+}
+
+func BenchmarkLibpqLookupContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	ids := make([]int, len(addresses))
+	for k, address := range addresses {
+		ids[k] = address.Id
+	}
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	sqlhbenchmarks.RunContenders(b, order, "", sqlhbenchmarks.Contenders{
+		{Name: "database/sql", Test: sqlhbenchmarks.StandardLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "database/sql prepared", Test: sqlhbenchmarks.StandardPreparedLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "GORM", Test: sqlhbenchmarks.GORMLookupContext(ids, false, gb)},
+		{Name: "GORM prepared", Test: sqlhbenchmarks.GORMLookupContext(ids, true, gb)},
+		{Name: "sqlx", Test: sqlhbenchmarks.SqlxLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "sqlx prepared", Test: sqlhbenchmarks.SqlxPreparedLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "scany", Test: sqlhbenchmarks.ScanyLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "scany prepared", Test: sqlhbenchmarks.ScanyPreparedLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "sqlh", Test: sqlhbenchmarks.SqlhLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "sqlh prepared", Test: sqlhbenchmarks.SqlhPreparedLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "squirrel", Test: sqlhbenchmarks.SquirrelLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+		{Name: "squirrel prepared", Test: sqlhbenchmarks.SquirrelPreparedLookupContext(ids, sqlhbenchmarks.Postgres, db)},
+	})
+}
+
+func BenchmarkLibpqErrors(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	stored := addresses[0]
+	if err = mdb.Insert(db, stored); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	} else if err = sqlhbenchmarks.ErrorUniqueIndex(db); err != nil {
+		b.Fatalf("creating unique index failed with %v", err.Error())
+	}
+	sqlxOps, err := sqlhbenchmarks.SqlxErrorOps(stored, sqlhbenchmarks.Postgres, db)
+	if err != nil {
+		b.Fatalf("preparing sqlx statements failed with %v", err.Error())
+	}
+	//
+	libraries := []struct {
+		Name       string
+		Ops        sqlhbenchmarks.ErrorOps
+		Deviations sqlhbenchmarks.ErrorDeviations
+	}{
+		{"database/sql", sqlhbenchmarks.StandardErrorOps(stored, sqlhbenchmarks.Postgres, db), nil},
+		{"GORM", sqlhbenchmarks.GORMErrorOps(stored, gb), sqlhbenchmarks.GORMErrorDeviations},
+		{"scany", sqlhbenchmarks.ScanyErrorOps(sqlhbenchmarks.Postgres, db), nil},
+		{"sqlh", sqlhbenchmarks.SqlhErrorOps(mdb, stored, sqlhbenchmarks.Postgres, db), sqlhbenchmarks.SqlhErrorDeviations},
+		{"sqlx", sqlxOps, nil},
+		{"squirrel", sqlhbenchmarks.SquirrelErrorOps(stored, sqlhbenchmarks.Postgres, db), nil},
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, c := range sqlhbenchmarks.ErrorCases {
+		contenders := sqlhbenchmarks.Contenders{}
+		for _, library := range libraries {
+			if op := library.Ops[c]; op != nil {
+				contenders = append(contenders, sqlhbenchmarks.Contender{
+					Name: library.Name,
+					Test: sqlhbenchmarks.ErrorPath(c, op, library.Deviations[c], sqlhbenchmarks.Postgres),
+				})
+			}
+		}
+		sqlhbenchmarks.RunContenders(b, order, string(c), contenders)
+	}
+}
+
+func BenchmarkLibpqMismatch(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	matrix, err := sqlhbenchmarks.NewMismatchMatrix(100, db)
+	if err != nil {
+		b.Fatalf("selecting expected rows failed with %v", err.Error())
+	}
+	//
+	libraries := []struct {
+		Name     string
+		Selector sqlhbenchmarks.MismatchSelector
+		Unmapped []string
+	}{
+		{"GORM", sqlhbenchmarks.GORMMismatchSelector(gb), []string{"created_tmz", "modified_tmz"}},
+		{"scany", sqlhbenchmarks.ScanyMismatchSelector(db), nil},
+		{"sqlh tags db,json", sqlhbenchmarks.SqlhMismatchSelector(sqlhbenchmarks.TagsMapper("db", "json"), db), nil},
+		{"sqlh tags json,db", sqlhbenchmarks.SqlhMismatchSelector(sqlhbenchmarks.TagsMapper("json", "db"), db), nil},
+		{"sqlx strict", sqlhbenchmarks.SqlxMismatchSelector(false, sqlhbenchmarks.Postgres, db), nil},
+		{"sqlx unsafe", sqlhbenchmarks.SqlxMismatchSelector(true, sqlhbenchmarks.Postgres, db), nil},
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, c := range sqlhbenchmarks.MismatchCases {
+		contenders := sqlhbenchmarks.Contenders{}
+		for _, library := range libraries {
+			contenders = append(contenders, sqlhbenchmarks.Contender{
+				Name: library.Name,
+				Test: matrix.Select(library.Name, c, library.Selector, library.Unmapped...),
+			})
+		}
+		sqlhbenchmarks.RunContenders(b, order, c.Name, contenders)
+	}
+	Reports["Libpq column mismatch outcomes"] = matrix
+}
+
 func BenchmarkLibpqInsert(b *testing.B) {
-	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq()
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -62,18 +681,25 @@ func BenchmarkLibpqInsert(b *testing.B) {
 		500,
 		1000,
 	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, nil, sqlhbenchmarks.ModelAddress)
+	}
 	//
 	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		b.Run(fmt.Sprintf("database/sql insert %v row(s)", lim), sqlhbenchmarks.StandardInsert(addresses[0:lim], grammar.Postgres, db))
-		b.Run(fmt.Sprintf("GORM insert %v row(s)", lim), sqlhbenchmarks.GORMInsert(addresses[0:lim], gb))
-		b.Run(fmt.Sprintf("squirrel insert %v row(s)", lim), sqlhbenchmarks.SquirrelInsert(addresses[0:lim], db))
-		b.Run(fmt.Sprintf("sqlh/model insert %v row(s)", lim), sqlhbenchmarks.ModelInsert(mdb, addresses[0:lim], db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("insert %v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardInsert(addresses[0:lim], grammar.Postgres, db))},
+			{Name: "GORM", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMInsert(addresses[0:lim], gb))},
+			{Name: "squirrel", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelInsert(addresses[0:lim], db))},
+			{Name: "sqlx", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxInsert(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "sqlh/model", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelInsert(mdb, addresses[0:lim], db))},
+		})
 	}
 }
 
 func BenchmarkLibpqPreparedInsert(b *testing.B) {
-	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq()
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -92,18 +718,25 @@ func BenchmarkLibpqPreparedInsert(b *testing.B) {
 		500,
 		1000,
 	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, nil, sqlhbenchmarks.ModelAddress)
+	}
 	//
 	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		b.Run(fmt.Sprintf("database/sql begin+prepare+insert %v row(s)", lim), sqlhbenchmarks.StandardPreparedInsert(addresses[0:lim], grammar.Postgres, db))
-		b.Run(fmt.Sprintf("GORM slice+insert %v row(s)", lim), sqlhbenchmarks.GORMPreparedInsert(addresses[0:lim], gb))
-		b.Run(fmt.Sprintf("squirrel begin+prepare+insert %v row(s)", lim), sqlhbenchmarks.SquirrelPreparedInsert(addresses[0:lim], db))
-		b.Run(fmt.Sprintf("sqlh/model begin+prepare+insert %v row(s)", lim), sqlhbenchmarks.ModelPreparedInsert(mdb, addresses[0:lim], db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardPreparedInsert(addresses[0:lim], grammar.Postgres, db))},
+			{Name: "GORM slice+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMPreparedInsert(addresses[0:lim], gb))},
+			{Name: "squirrel begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelPreparedInsert(addresses[0:lim], db))},
+			{Name: "sqlx begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxPreparedInsert(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "sqlh/model begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelPreparedInsert(mdb, addresses[0:lim], db))},
+		})
 	}
 }
 
-func BenchmarkLibpqUpdate(b *testing.B) {
-	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq()
+func BenchmarkLibpqBulkInsert(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -115,16 +748,42 @@ func BenchmarkLibpqUpdate(b *testing.B) {
 		b.Fatalf("getting models failed with %v", err.Error())
 	}
 	//
-	if err = mdb.Insert(db, addresses); err != nil {
-		b.Fatalf("seeding database with %v", err.Error())
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, nil, sqlhbenchmarks.ModelAddress)
+	}
+	//
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql multi-row insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardBulkInsert(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "database/sql pq.CopyIn", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardCopyInsert(addresses[0:lim], db))},
+			{Name: "GORM slice+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMPreparedInsert(addresses[0:lim], gb))},
+			{Name: "sqlx batch insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxBulkInsert(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "squirrel multi-row insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelBulkInsert(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "sqlh/model begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelPreparedInsert(mdb, addresses[0:lim], db))},
+		})
+	}
+}
+
+func BenchmarkLibpqBulkInsertContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
 	}
-	// Now modify every address.
-	for _, address := range addresses {
-		address.Street = address.Street + address.Street
-		address.City = address.City + address.City
-		address.State = address.State + address.State
-		address.Zip = address.Zip + address.Zip
-		address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour) // Just to make sure modified time updates
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
 	}
 	//
 	limits := []int{
@@ -134,43 +793,165 @@ func BenchmarkLibpqUpdate(b *testing.B) {
 		500,
 		1000,
 	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, nil, sqlhbenchmarks.ModelAddress)
+	}
+	//
 	b.ResetTimer()
-	var tx *sql.Tx
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		if tx, err = db.Begin(); err != nil {
-			b.Fatalf("pg failed with begin %v", err.Error())
-		}
-		b.Run(fmt.Sprintf("database/sql update %v row(s)", lim), sqlhbenchmarks.StandardUpdate(addresses[0:lim], grammar.Postgres, tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("pg failed with rollback %v", err.Error())
-		}
-		//
-		{
-			tx := gb.Begin()
-			b.Run(fmt.Sprintf("GORM update %v row(s)", lim), sqlhbenchmarks.GORMUpdate(addresses[0:lim], tx))
-			tx.Rollback()
-		}
-		//
-		if tx, err = db.Begin(); err != nil {
-			b.Fatalf("pg failed with begin %v", err.Error())
-		}
-		b.Run(fmt.Sprintf("squirrel update %v row(s)", lim), sqlhbenchmarks.SquirrelUpdate(addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("pg failed with rollback %v", err.Error())
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql multi-row insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardBulkInsertContext(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "database/sql pq.CopyIn", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardCopyInsertContext(addresses[0:lim], db))},
+			{Name: "GORM slice+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMPreparedInsertContext(addresses[0:lim], gb))},
+			{Name: "sqlx batch insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxBulkInsertContext(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "squirrel multi-row insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelBulkInsertContext(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "sqlh/model begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelPreparedInsertContext(mdb, addresses[0:lim], db))},
+		})
+	}
+}
+
+func BenchmarkLibpqTxInsert(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		100,
+		1000,
+	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, nil, sqlhbenchmarks.ModelAddress)
+	}
+	//
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		for _, mode := range sqlhbenchmarks.TxModes {
+			sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v row(s)", mode.Name, lim), sqlhbenchmarks.Contenders{
+				{Name: "database/sql", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.StandardTxInserter(sqlhbenchmarks.Postgres), addresses[0:lim], db))},
+				{Name: "GORM", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMTxInsert(mode, addresses[0:lim], gb))},
+				{Name: "sqlx", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.SqlxTxInserter(sqlhbenchmarks.Postgres), addresses[0:lim], db))},
+				{Name: "squirrel", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.SquirrelTxInserter(sqlhbenchmarks.Postgres), addresses[0:lim], db))},
+				{Name: "sqlh/model", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.ModelTxInserter(mdb), addresses[0:lim], db))},
+			})
 		}
-		//
-		if tx, err = db.Begin(); err != nil {
+	}
+}
+
+func BenchmarkLibpqInsertContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, nil, sqlhbenchmarks.ModelAddress)
+	}
+	//
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("insert %v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardInsertContext(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "GORM", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMInsertContext(addresses[0:lim], gb))},
+			{Name: "sqlx", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxInsertContext(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "squirrel", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelInsertContext(addresses[0:lim], sqlhbenchmarks.Postgres, db))},
+			{Name: "sqlh/model", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelInsertContext(mdb, addresses[0:lim], db))},
+		})
+	}
+}
+
+func BenchmarkLibpqUpdate(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, seed, sqlhbenchmarks.ModelAddress)
+	}
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
 			b.Fatalf("pg failed with begin %v", err.Error())
 		}
-		b.Run(fmt.Sprintf("sqlh/model update %v row(s)", lim), sqlhbenchmarks.ModelUpdate(mdb, addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("pg failed with rollback %v", err.Error())
-		}
+		return tx
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("update %v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdate(addresses[0:lim], grammar.Postgres, tx), tx.Rollback
+			})},
+			{Name: "GORM", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx := gb.Begin()
+				return sqlhbenchmarks.GORMUpdate(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			})},
+			{Name: "squirrel", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.SquirrelUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlx", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx, err := sqlx.NewDb(db, string(sqlhbenchmarks.Postgres)).Beginx()
+				if err != nil {
+					b.Fatalf("pg failed with begin %v", err.Error())
+				}
+				return sqlhbenchmarks.SqlxUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlh/model", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
 	}
 }
 
 func BenchmarkLibpqPreparedUpdate(b *testing.B) {
-	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq()
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -181,17 +962,18 @@ func BenchmarkLibpqPreparedUpdate(b *testing.B) {
 	if err != nil {
 		b.Fatalf("getting models failed with %v", err.Error())
 	}
-	//
-	if err = mdb.Insert(db, addresses); err != nil {
-		b.Fatalf("seeding database with %v", err.Error())
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, seed, sqlhbenchmarks.ModelAddress)
 	}
-	// Now modify every address.
-	for _, address := range addresses {
-		address.Street = address.Street + address.Street
-		address.City = address.City + address.City
-		address.State = address.State + address.State
-		address.Zip = address.Zip + address.Zip
-		address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour) // Just to make sure modified time updates
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
+			b.Fatalf("pg failed with begin %v", err.Error())
+		}
+		return tx
 	}
 	//
 	limits := []int{
@@ -202,42 +984,228 @@ func BenchmarkLibpqPreparedUpdate(b *testing.B) {
 		1000,
 	}
 	b.ResetTimer()
-	var tx *sql.Tx
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		if tx, err = db.Begin(); err != nil {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardPreparedUpdate(addresses[0:lim], grammar.Postgres, tx), tx.Rollback
+			})},
+			{Name: "GORM update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx := gb.Begin()
+				return sqlhbenchmarks.GORMPreparedUpdate(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			})},
+			{Name: "squirrel begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.SquirrelPreparedUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlx begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx, err := sqlx.NewDb(db, string(sqlhbenchmarks.Postgres)).Beginx()
+				if err != nil {
+					b.Fatalf("pg failed with begin %v", err.Error())
+				}
+				return sqlhbenchmarks.SqlxPreparedUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlh/model begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelPreparedUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
+	}
+}
+
+func BenchmarkLibpqBulkUpdate(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, seed, sqlhbenchmarks.ModelAddress)
+	}
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
 			b.Fatalf("pg failed with begin %v", err.Error())
 		}
-		b.Run(fmt.Sprintf("database/sql begin+prepare+update %v row(s)", lim), sqlhbenchmarks.StandardPreparedUpdate(addresses[0:lim], grammar.Postgres, tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("pg failed with rollback %v", err.Error())
-		}
-		//
-		{
-			tx := gb.Begin()
-			b.Run(fmt.Sprintf("GORM update %v row(s)", lim), sqlhbenchmarks.GORMPreparedUpdate(addresses[0:lim], tx))
-			tx.Rollback()
-		}
-		//
-		if tx, err = db.Begin(); err != nil {
+		return tx
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql update from values", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateFromValues(addresses[0:lim], sqlhbenchmarks.Postgres, tx), tx.Rollback
+			})},
+			{Name: "database/sql update case", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateCase(addresses[0:lim], sqlhbenchmarks.Postgres, tx), tx.Rollback
+			})},
+			{Name: "database/sql temp table copy+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateCopy(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "GORM slice+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx := gb.Begin()
+				return sqlhbenchmarks.GORMPreparedUpdate(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			})},
+			{Name: "sqlh/model update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlh/model begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelPreparedUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
+	}
+}
+
+func BenchmarkLibpqBulkUpdateContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, seed, sqlhbenchmarks.ModelAddress)
+	}
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
 			b.Fatalf("pg failed with begin %v", err.Error())
 		}
-		b.Run(fmt.Sprintf("squirrel begin+prepare+update %v row(s)", lim), sqlhbenchmarks.SquirrelPreparedUpdate(addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("pg failed with rollback %v", err.Error())
-		}
-		//
-		if tx, err = db.Begin(); err != nil {
+		return tx
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql update from values", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateFromValuesContext(addresses[0:lim], sqlhbenchmarks.Postgres, tx), tx.Rollback
+			})},
+			{Name: "database/sql update case", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateCaseContext(addresses[0:lim], sqlhbenchmarks.Postgres, tx), tx.Rollback
+			})},
+			{Name: "database/sql temp table copy+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateCopyContext(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "GORM slice+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx := gb.Begin()
+				return sqlhbenchmarks.GORMPreparedUpdateContext(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			})},
+			{Name: "sqlh/model update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelUpdateContext(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
+	}
+}
+
+func BenchmarkLibpqUpdateContext(b *testing.B) {
+	skip, db, gb, err := sqlhbenchmarks.ConnectLibpq(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Postgres failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.LibpqModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Postgres, seed, sqlhbenchmarks.ModelAddress)
+	}
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
 			b.Fatalf("pg failed with begin %v", err.Error())
 		}
-		b.Run(fmt.Sprintf("sqlh/model begin+prepare+update %v row(s)", lim), sqlhbenchmarks.ModelPreparedUpdate(mdb, addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("pg failed with rollback %v", err.Error())
-		}
-		b.StopTimer()
-		for _, address := range addresses[0:lim] {
-			// Due to how model package works we need to reset the modify times here.
-			address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour)
-		}
-		b.StartTimer()
+		return tx
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("update %v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateContext(addresses[0:lim], sqlhbenchmarks.Postgres, tx), tx.Rollback
+			})},
+			{Name: "GORM", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx := gb.Begin()
+				return sqlhbenchmarks.GORMUpdateContext(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			})},
+			{Name: "sqlx", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx, err := sqlx.NewDb(db, string(sqlhbenchmarks.Postgres)).Beginx()
+				if err != nil {
+					b.Fatalf("pg failed with begin %v", err.Error())
+				}
+				return sqlhbenchmarks.SqlxUpdateContext(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "squirrel", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.SquirrelUpdateContext(addresses[0:lim], sqlhbenchmarks.Postgres, tx), tx.Rollback
+			})},
+			{Name: "sqlh/model", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelUpdateContext(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
 	}
 }
@@ -4,14 +4,16 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
-	"time"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/nofeaturesonlybugs/sqlh/grammar"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/generator"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
 
 func BenchmarkSqliteSelect(b *testing.B) {
-	skip, db, err := sqlhbenchmarks.ConnectSqlite()
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -33,16 +35,559 @@ func BenchmarkSqliteSelect(b *testing.B) {
 		500,
 		1000,
 	}
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, limit := range limits {
-		b.Run(fmt.Sprintf("database/sql %v rows", limit), sqlhbenchmarks.StandardSelect(limit, db))
-		b.Run(fmt.Sprintf("sqlx %v rows", limit), sqlhbenchmarks.SqlxSelect(limit, db))
-		b.Run(fmt.Sprintf("scany %v rows", limit), sqlhbenchmarks.ScanySelect(limit, db))
-		b.Run(fmt.Sprintf("sqlh %v rows", limit), sqlhbenchmarks.SqlhSelect(limit, db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelect(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelect(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelect(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelect(limit, db)},
+		})
 	}
 }
 
+func BenchmarkSqlitePreparedSelect(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardPreparedSelect(limit, db)},
+			// {Name: "GORM", Test: sqlhbenchmarks.GORMPreparedSelect(limit, gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxPreparedSelect(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanyPreparedSelect(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhPreparedSelect(limit, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelPreparedSelect(limit, db)},
+		})
+	}
+}
+
+func BenchmarkSqliteSelectIn(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	ids := make([]int, len(addresses))
+	for k, address := range addresses {
+		ids[k] = address.Id
+	}
+	//
+	sizes := []int{
+		1,
+		10,
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, size := range sizes {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v ids", size), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectIn(ids[0:size], sqlhbenchmarks.Sqlite, db)},
+			// {Name: "GORM", Test: sqlhbenchmarks.GORMSelectIn(ids[0:size], gb)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectIn(ids[0:size], sqlhbenchmarks.Sqlite, db)},
+			{Name: "squirrel", Test: sqlhbenchmarks.SquirrelSelectIn(ids[0:size], sqlhbenchmarks.Sqlite, db)},
+		})
+	}
+}
+
+func BenchmarkSqliteSelectGenerated(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	_, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		10000,
+		100000,
+		1000000,
+	}
+	addresses := generator.New(generator.DefaultConfig).Addresses(limits[len(limits)-1])
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelect(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelect(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelect(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelect(limit, db)},
+		})
+	}
+}
+
+func BenchmarkSqliteSelectStream(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	_, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		100000,
+		1000000,
+	}
+	addresses := generator.New(generator.DefaultConfig).Addresses(limits[len(limits)-1])
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	addresses = nil // Not part of the measured heap.
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectStream(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectStream(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectStream(limit, db)},
+			{Name: "set.BoundMapping", Test: sqlhbenchmarks.SetBoundSelectStream(limit, db)},
+		})
+	}
+}
+
+func BenchmarkSqliteCancel(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	_, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limit := 100000
+	addresses := generator.New(generator.DefaultConfig).Addresses(limit)
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	addresses = nil
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v of %v rows", sqlhbenchmarks.CancelAfterRows, limit), sqlhbenchmarks.Contenders{
+		{Name: "database/sql", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.StandardCancelSelector(db), true, limit, db)},
+		// {Name: "GORM", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.GORMCancelSelector(gb), true, limit, db)},
+		{Name: "scany", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.ScanyCancelSelector(db), true, limit, db)},
+		{Name: "sqlh", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.SqlhCancelSelector(db), false, limit, db)},
+		{Name: "sqlx", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.SqlxCancelSelector(db), true, limit, db)},
+		{Name: "squirrel", Test: sqlhbenchmarks.CancelSelect(sqlhbenchmarks.SquirrelCancelSelector(db), true, limit, db)},
+	})
+}
+
+func BenchmarkSqliteSelectSales(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.SalesModels...)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	sqlhbenchmarks.SeedTables(b, func() error {
+		return sqlhbenchmarks.SeedSales(types.SaleRecords(), sqlhbenchmarks.Sqlite, db)
+	})
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectSales(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectSales(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectSales(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectSales(limit, db)},
+		})
+	}
+}
+
+func BenchmarkSqliteSelectNested(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.SalesModels...)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	sqlhbenchmarks.SeedTables(b, func() error {
+		return sqlhbenchmarks.SeedSales(types.SaleRecords(), sqlhbenchmarks.Sqlite, db)
+	})
+	//
+	embeds := []struct {
+		name     string
+		pointers bool
+	}{
+		{"values", false},
+		{"pointers", true},
+	}
+	limits := []int{
+		5,
+		50,
+		100,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, embed := range embeds {
+		for _, limit := range limits {
+			sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v rows", embed.name, limit), sqlhbenchmarks.Contenders{
+				{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectNested(embed.pointers, limit, db)},
+				{Name: "scany", Test: sqlhbenchmarks.ScanySelectNested(embed.pointers, limit, db)},
+				{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectNested(embed.pointers, limit, db)},
+			})
+		}
+	}
+}
+
+func BenchmarkSqliteSelectNullable(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelNullableAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	kinds := []string{
+		types.NullablePtr,
+		types.NullableSql,
+	}
+	limits := []int{
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, ratio := range sqlhbenchmarks.NullRatios(b) {
+		seed := func() error {
+			return sqlhbenchmarks.SeedNullableAddresses(limits[len(limits)-1], ratio, sqlhbenchmarks.Sqlite, db)
+		}
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, seed, sqlhbenchmarks.ModelNullableAddress)
+		for _, kind := range kinds {
+			for _, limit := range limits {
+				sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v%% null %v rows", kind, sqlhbenchmarks.NullPercent(ratio), limit), sqlhbenchmarks.Contenders{
+					{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectNullable(kind, limit, db)},
+					{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectNullable(kind, limit, db)},
+					{Name: "scany", Test: sqlhbenchmarks.ScanySelectNullable(kind, limit, db)},
+					{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectNullable(kind, limit, db)},
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkSqliteSelectKitchenSink(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelKitchenSink)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	sqlhbenchmarks.SeedTables(b, func() error {
+		return sqlhbenchmarks.SeedKitchenSinks(limits[len(limits)-1], sqlhbenchmarks.Sqlite, db)
+	})
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectKitchenSink(limit, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectKitchenSink(limit, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectKitchenSink(limit, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectKitchenSink(limit, db)},
+		})
+	}
+}
+
+func BenchmarkSqliteSelectWide(b *testing.B) {
+	widths := []int{
+		50,
+		100,
+		200,
+	}
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	models := []string{}
+	for _, columns := range widths {
+		models = append(models, sqlhbenchmarks.WideModel(columns))
+	}
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, models...)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	for _, columns := range widths {
+		sqlhbenchmarks.SeedTables(b, func() error {
+			return sqlhbenchmarks.SeedWide(columns, limits[len(limits)-1], sqlhbenchmarks.Sqlite, db)
+		})
+	}
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, columns := range widths {
+		for _, limit := range limits {
+			sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v columns %v rows", columns, limit), sqlhbenchmarks.Contenders{
+				{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectWide(columns, limit, db)},
+				{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectWide(columns, limit, db)},
+				{Name: "scany", Test: sqlhbenchmarks.ScanySelectWide(columns, limit, db)},
+				{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectWide(columns, limit, db)},
+			})
+		}
+	}
+}
+
+func BenchmarkSqliteSelectMapper(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		5,
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		contenders := sqlhbenchmarks.Contenders{}
+		for _, config := range sqlhbenchmarks.MapperConfigs {
+			contenders = append(contenders,
+				sqlhbenchmarks.Contender{Name: fmt.Sprintf("sqlh %v shared mapper", config.Name), Test: sqlhbenchmarks.SqlhSelectMapper(config, true, limit, db)},
+				sqlhbenchmarks.Contender{Name: fmt.Sprintf("sqlh %v fresh mapper", config.Name), Test: sqlhbenchmarks.SqlhSelectMapper(config, false, limit, db)},
+			)
+		}
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), contenders)
+	}
+}
+
+func BenchmarkSqliteColdStart(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	//
+	limits := []int{
+		1,
+		100,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("cold start %v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "sqlx", Test: sqlhbenchmarks.ColdStart(sqlhbenchmarks.SqlxColdSelector(sqlhbenchmarks.Sqlite, db), limit)},
+			{Name: "scany", Test: sqlhbenchmarks.ColdStart(sqlhbenchmarks.ScanyColdSelector(db), limit)},
+			{Name: "sqlh", Test: sqlhbenchmarks.ColdStart(sqlhbenchmarks.SqlhColdSelector(b, db), limit)},
+		})
+	}
+}
+
+func BenchmarkSqliteLookup(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	ids := make([]int, len(addresses))
+	for k, address := range addresses {
+		ids[k] = address.Id
+	}
+	//
+	order := sqlhbenchmarks.NewOrder(b)
+	sqlhbenchmarks.RunContenders(b, order, "", sqlhbenchmarks.Contenders{
+		{Name: "database/sql", Test: sqlhbenchmarks.StandardLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "database/sql prepared", Test: sqlhbenchmarks.StandardPreparedLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		// {Name: "GORM", Test: sqlhbenchmarks.GORMLookup(ids, false, gb)},
+		// {Name: "GORM prepared", Test: sqlhbenchmarks.GORMLookup(ids, true, gb)},
+		{Name: "sqlx", Test: sqlhbenchmarks.SqlxLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "sqlx prepared", Test: sqlhbenchmarks.SqlxPreparedLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "scany", Test: sqlhbenchmarks.ScanyLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "scany prepared", Test: sqlhbenchmarks.ScanyPreparedLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "sqlh", Test: sqlhbenchmarks.SqlhLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "sqlh prepared", Test: sqlhbenchmarks.SqlhPreparedLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "squirrel", Test: sqlhbenchmarks.SquirrelLookup(ids, sqlhbenchmarks.Sqlite, db)},
+		{Name: "squirrel prepared", Test: sqlhbenchmarks.SquirrelPreparedLookup(ids, sqlhbenchmarks.Sqlite, db)},
+	})
+}
+
+func BenchmarkSqliteErrors(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	stored := addresses[0]
+	if err = mdb.Insert(db, stored); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	} else if err = sqlhbenchmarks.ErrorUniqueIndex(db); err != nil {
+		b.Fatalf("creating unique index failed with %v", err.Error())
+	}
+	sqlxOps, err := sqlhbenchmarks.SqlxErrorOps(stored, sqlhbenchmarks.Sqlite, db)
+	if err != nil {
+		b.Fatalf("preparing sqlx statements failed with %v", err.Error())
+	}
+	//
+	libraries := []struct {
+		Name       string
+		Ops        sqlhbenchmarks.ErrorOps
+		Deviations sqlhbenchmarks.ErrorDeviations
+	}{
+		{"database/sql", sqlhbenchmarks.StandardErrorOps(stored, sqlhbenchmarks.Sqlite, db), nil},
+		// {"GORM", sqlhbenchmarks.GORMErrorOps(stored, gb), sqlhbenchmarks.GORMErrorDeviations},
+		{"scany", sqlhbenchmarks.ScanyErrorOps(sqlhbenchmarks.Sqlite, db), nil},
+		{"sqlh", sqlhbenchmarks.SqlhErrorOps(mdb, stored, sqlhbenchmarks.Sqlite, db), sqlhbenchmarks.SqlhErrorDeviations},
+		{"sqlx", sqlxOps, nil},
+		{"squirrel", sqlhbenchmarks.SquirrelErrorOps(stored, sqlhbenchmarks.Sqlite, db), nil},
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, c := range sqlhbenchmarks.ErrorCases {
+		contenders := sqlhbenchmarks.Contenders{}
+		for _, library := range libraries {
+			if op := library.Ops[c]; op != nil {
+				contenders = append(contenders, sqlhbenchmarks.Contender{
+					Name: library.Name,
+					Test: sqlhbenchmarks.ErrorPath(c, op, library.Deviations[c], sqlhbenchmarks.Sqlite),
+				})
+			}
+		}
+		sqlhbenchmarks.RunContenders(b, order, string(c), contenders)
+	}
+}
+
+func BenchmarkSqliteMismatch(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	if err = mdb.Insert(db, addresses); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+	matrix, err := sqlhbenchmarks.NewMismatchMatrix(100, db)
+	if err != nil {
+		b.Fatalf("selecting expected rows failed with %v", err.Error())
+	}
+	//
+	libraries := []struct {
+		Name     string
+		Selector sqlhbenchmarks.MismatchSelector
+		Unmapped []string
+	}{
+		// {"GORM", sqlhbenchmarks.GORMMismatchSelector(gb), []string{"created_tmz", "modified_tmz"}},
+		{"scany", sqlhbenchmarks.ScanyMismatchSelector(db), nil},
+		{"sqlh tags db,json", sqlhbenchmarks.SqlhMismatchSelector(sqlhbenchmarks.TagsMapper("db", "json"), db), nil},
+		{"sqlh tags json,db", sqlhbenchmarks.SqlhMismatchSelector(sqlhbenchmarks.TagsMapper("json", "db"), db), nil},
+		{"sqlx strict", sqlhbenchmarks.SqlxMismatchSelector(false, sqlhbenchmarks.Sqlite, db), nil},
+		{"sqlx unsafe", sqlhbenchmarks.SqlxMismatchSelector(true, sqlhbenchmarks.Sqlite, db), nil},
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, c := range sqlhbenchmarks.MismatchCases {
+		contenders := sqlhbenchmarks.Contenders{}
+		for _, library := range libraries {
+			contenders = append(contenders, sqlhbenchmarks.Contender{
+				Name: library.Name,
+				Test: matrix.Select(library.Name, c, library.Selector, library.Unmapped...),
+			})
+		}
+		sqlhbenchmarks.RunContenders(b, order, c.Name, contenders)
+	}
+	Reports["Sqlite column mismatch outcomes"] = matrix
+}
+
 func BenchmarkSqliteInsert(b *testing.B) {
-	skip, db, err := sqlhbenchmarks.ConnectSqlite()
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -61,18 +606,25 @@ func BenchmarkSqliteInsert(b *testing.B) {
 		500,
 		1000,
 	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, nil, sqlhbenchmarks.ModelAddress)
+	}
 	//
 	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		b.Run(fmt.Sprintf("database/sql insert %v row(s)", lim), sqlhbenchmarks.StandardInsert(addresses[0:lim], grammar.Default, db))
-		// b.Run(fmt.Sprintf("GORM insert %v row(s)", lim), sqlhbenchmarks.GORMInsert(addresses[0:lim], gb))
-		b.Run(fmt.Sprintf("squirrel insert %v row(s)", lim), sqlhbenchmarks.SquirrelInsert(addresses[0:lim], db))
-		b.Run(fmt.Sprintf("sqlh/model insert %v row(s)", lim), sqlhbenchmarks.ModelInsert(mdb, addresses[0:lim], db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("insert %v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardInsert(addresses[0:lim], grammar.Default, db))},
+			// {Name: "GORM", Test: sqlhbenchmarks.GORMInsert(addresses[0:lim], gb)},
+			{Name: "squirrel", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelInsert(addresses[0:lim], db))},
+			{Name: "sqlx", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxInsert(addresses[0:lim], sqlhbenchmarks.Sqlite, db))},
+			{Name: "sqlh/model", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelInsert(mdb, addresses[0:lim], db))},
+		})
 	}
 }
 
 func BenchmarkSqlitePreparedInsert(b *testing.B) {
-	skip, db, err := sqlhbenchmarks.ConnectSqlite()
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -91,18 +643,25 @@ func BenchmarkSqlitePreparedInsert(b *testing.B) {
 		500,
 		1000,
 	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, nil, sqlhbenchmarks.ModelAddress)
+	}
 	//
 	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		b.Run(fmt.Sprintf("database/sql begin+prepare+insert %v row(s)", lim), sqlhbenchmarks.StandardPreparedInsert(addresses[0:lim], grammar.Default, db))
-		// b.Run(fmt.Sprintf("GORM slice+insert %v row(s)", lim), sqlhbenchmarks.GORMPreparedInsert(addresses[0:lim], gb))
-		b.Run(fmt.Sprintf("squirrel begin+prepare+insert %v row(s)", lim), sqlhbenchmarks.SquirrelPreparedInsert(addresses[0:lim], db))
-		b.Run(fmt.Sprintf("sqlh/model begin+prepare+insert %v row(s)", lim), sqlhbenchmarks.ModelPreparedInsert(mdb, addresses[0:lim], db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardPreparedInsert(addresses[0:lim], grammar.Default, db))},
+			// {Name: "GORM slice+insert", Test: sqlhbenchmarks.GORMPreparedInsert(addresses[0:lim], gb)},
+			{Name: "squirrel begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelPreparedInsert(addresses[0:lim], db))},
+			{Name: "sqlx begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxPreparedInsert(addresses[0:lim], sqlhbenchmarks.Sqlite, db))},
+			{Name: "sqlh/model begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelPreparedInsert(mdb, addresses[0:lim], db))},
+		})
 	}
 }
 
-func BenchmarkSqliteUpdate(b *testing.B) {
-	skip, db, err := sqlhbenchmarks.ConnectSqlite()
+func BenchmarkSqliteBulkInsert(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -114,18 +673,6 @@ func BenchmarkSqliteUpdate(b *testing.B) {
 		b.Fatalf("getting models failed with %v", err.Error())
 	}
 	//
-	if err = mdb.Insert(db, addresses); err != nil {
-		b.Fatalf("seeding database with %v", err.Error())
-	}
-	// Now modify every address.
-	for _, address := range addresses {
-		address.Street = address.Street + address.Street
-		address.City = address.City + address.City
-		address.State = address.State + address.State
-		address.Zip = address.Zip + address.Zip
-		address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour) // Just to make sure modified time updates
-	}
-	//
 	limits := []int{
 		5,
 		50,
@@ -133,43 +680,127 @@ func BenchmarkSqliteUpdate(b *testing.B) {
 		500,
 		1000,
 	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, nil, sqlhbenchmarks.ModelAddress)
+	}
+	//
 	b.ResetTimer()
-	var tx *sql.Tx
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		if tx, err = db.Begin(); err != nil {
-			b.Fatalf("sqlite failed with begin %v", err.Error())
-		}
-		b.Run(fmt.Sprintf("database/sql update %v row(s)", lim), sqlhbenchmarks.StandardUpdate(addresses[0:lim], grammar.Default, tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("sqlite failed with rollback %v", err.Error())
-		}
-		//
-		// {
-		// 	tx := gb.Begin()
-		// 	b.Run(fmt.Sprintf("GORM update %v row(s)", lim), sqlhbenchmarks.GORMUpdate(addresses[0:lim], tx))
-		// 	tx.Rollback()
-		// }
-		//
-		if tx, err = db.Begin(); err != nil {
-			b.Fatalf("sqlite failed with begin %v", err.Error())
-		}
-		b.Run(fmt.Sprintf("squirrel update %v row(s)", lim), sqlhbenchmarks.SquirrelUpdate(addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("sqlite failed with rollback %v", err.Error())
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql multi-row insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.StandardBulkInsert(addresses[0:lim], sqlhbenchmarks.Sqlite, db))},
+			// {Name: "GORM slice+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMPreparedInsert(addresses[0:lim], gb))},
+			{Name: "sqlx batch insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SqlxBulkInsert(addresses[0:lim], sqlhbenchmarks.Sqlite, db))},
+			{Name: "squirrel multi-row insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.SquirrelBulkInsert(addresses[0:lim], sqlhbenchmarks.Sqlite, db))},
+			{Name: "sqlh/model begin+prepare+insert", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.ModelPreparedInsert(mdb, addresses[0:lim], db))},
+		})
+	}
+}
+
+func BenchmarkSqliteTxInsert(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	//
+	limits := []int{
+		100,
+		1000,
+	}
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, nil, sqlhbenchmarks.ModelAddress)
+	}
+	//
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		for _, mode := range sqlhbenchmarks.TxModes {
+			sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v row(s)", mode.Name, lim), sqlhbenchmarks.Contenders{
+				{Name: "database/sql", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.StandardTxInserter(sqlhbenchmarks.Sqlite), addresses[0:lim], db))},
+				// {Name: "GORM", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.GORMTxInsert(mode, addresses[0:lim], gb))},
+				{Name: "sqlx", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.SqlxTxInserter(sqlhbenchmarks.Sqlite), addresses[0:lim], db))},
+				{Name: "squirrel", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.SquirrelTxInserter(sqlhbenchmarks.Sqlite), addresses[0:lim], db))},
+				{Name: "sqlh/model", Test: sqlhbenchmarks.WithReset(reset, sqlhbenchmarks.TxInsert(mode, sqlhbenchmarks.ModelTxInserter(mdb), addresses[0:lim], db))},
+			})
 		}
-		//
-		if tx, err = db.Begin(); err != nil {
+	}
+}
+
+func BenchmarkSqliteUpdate(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, seed, sqlhbenchmarks.ModelAddress)
+	}
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
 			b.Fatalf("sqlite failed with begin %v", err.Error())
 		}
-		b.Run(fmt.Sprintf("sqlh/model update %v row(s)", lim), sqlhbenchmarks.ModelUpdate(mdb, addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("sqlite failed with rollback %v", err.Error())
-		}
+		return tx
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("update %v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdate(addresses[0:lim], grammar.Default, tx), tx.Rollback
+			})},
+			// {Name: "GORM", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+			// 	reset(b)
+			// 	tx := gb.Begin()
+			// 	return sqlhbenchmarks.GORMUpdate(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			// })},
+			{Name: "squirrel", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.SquirrelUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlx", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx, err := sqlx.NewDb(db, string(sqlhbenchmarks.Sqlite)).Beginx()
+				if err != nil {
+					b.Fatalf("sqlite failed with begin %v", err.Error())
+				}
+				return sqlhbenchmarks.SqlxUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlh/model", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
 	}
 }
 
 func BenchmarkSqlitePreparedUpdate(b *testing.B) {
-	skip, db, err := sqlhbenchmarks.ConnectSqlite()
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
 	if skip != "" {
 		b.Skipf("skipping -- " + skip)
 	} else if err != nil {
@@ -180,17 +811,18 @@ func BenchmarkSqlitePreparedUpdate(b *testing.B) {
 	if err != nil {
 		b.Fatalf("getting models failed with %v", err.Error())
 	}
-	//
-	if err = mdb.Insert(db, addresses); err != nil {
-		b.Fatalf("seeding database with %v", err.Error())
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, seed, sqlhbenchmarks.ModelAddress)
 	}
-	// Now modify every address.
-	for _, address := range addresses {
-		address.Street = address.Street + address.Street
-		address.City = address.City + address.City
-		address.State = address.State + address.State
-		address.Zip = address.Zip + address.Zip
-		address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour) // Just to make sure modified time updates
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
+			b.Fatalf("sqlite failed with begin %v", err.Error())
+		}
+		return tx
 	}
 	//
 	limits := []int{
@@ -201,42 +833,96 @@ func BenchmarkSqlitePreparedUpdate(b *testing.B) {
 		1000,
 	}
 	b.ResetTimer()
-	var tx *sql.Tx
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, lim := range limits {
-		if tx, err = db.Begin(); err != nil {
-			b.Fatalf("sqlite failed with begin %v", err.Error())
-		}
-		b.Run(fmt.Sprintf("database/sql begin+prepare+update %v row(s)", lim), sqlhbenchmarks.StandardPreparedUpdate(addresses[0:lim], grammar.Default, tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("sqlite failed with rollback %v", err.Error())
-		}
-		//
-		// {
-		// 	tx := gb.Begin()
-		// 	b.Run(fmt.Sprintf("GORM update %v row(s)", lim), sqlhbenchmarks.GORMPreparedUpdate(addresses[0:lim], tx))
-		// 	tx.Rollback()
-		// }
-		//
-		if tx, err = db.Begin(); err != nil {
-			b.Fatalf("sqlite failed with begin %v", err.Error())
-		}
-		b.Run(fmt.Sprintf("squirrel begin+prepare+update %v row(s)", lim), sqlhbenchmarks.SquirrelPreparedUpdate(addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("sqlite failed with rollback %v", err.Error())
-		}
-		//
-		if tx, err = db.Begin(); err != nil {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardPreparedUpdate(addresses[0:lim], grammar.Default, tx), tx.Rollback
+			})},
+			// {Name: "GORM update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+			// 	reset(b)
+			// 	tx := gb.Begin()
+			// 	return sqlhbenchmarks.GORMPreparedUpdate(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			// })},
+			{Name: "squirrel begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.SquirrelPreparedUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlx begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				reset(b)
+				tx, err := sqlx.NewDb(db, string(sqlhbenchmarks.Sqlite)).Beginx()
+				if err != nil {
+					b.Fatalf("sqlite failed with begin %v", err.Error())
+				}
+				return sqlhbenchmarks.SqlxPreparedUpdate(addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlh/model begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelPreparedUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
+	}
+}
+
+func BenchmarkSqliteBulkUpdate(b *testing.B) {
+	skip, db, err := sqlhbenchmarks.ConnectSqlite(b, sqlhbenchmarks.ModelAddress)
+	if skip != "" {
+		b.Skipf("skipping -- " + skip)
+	} else if err != nil {
+		b.Fatalf("connect to Sqlite failed with %v", err.Error())
+	}
+	//
+	addresses, mdb, err := sqlhbenchmarks.SqliteModels()
+	if err != nil {
+		b.Fatalf("getting models failed with %v", err.Error())
+	}
+	// Every library updates the same freshly seeded rows.
+	seed := sqlhbenchmarks.UpdateSeed(mdb, addresses, db)
+	reset := func(b *testing.B) {
+		sqlhbenchmarks.ResetTables(b, db, sqlhbenchmarks.Sqlite, seed, sqlhbenchmarks.ModelAddress)
+	}
+	begin := func(b *testing.B) *sql.Tx {
+		reset(b)
+		tx, err := db.Begin()
+		if err != nil {
 			b.Fatalf("sqlite failed with begin %v", err.Error())
 		}
-		b.Run(fmt.Sprintf("sqlh/model begin+prepare+update %v row(s)", lim), sqlhbenchmarks.ModelPreparedUpdate(mdb, addresses[0:lim], tx))
-		if err = tx.Rollback(); err != nil {
-			b.Fatalf("sqlite failed with rollback %v", err.Error())
-		}
-		b.StopTimer()
-		for _, address := range addresses[0:lim] {
-			// Due to how model package works we need to reset the modify times here.
-			address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour)
-		}
-		b.StartTimer()
+		return tx
+	}
+	//
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	b.ResetTimer()
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, lim := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v row(s)", lim), sqlhbenchmarks.Contenders{
+			{Name: "database/sql update from values", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateFromValues(addresses[0:lim], sqlhbenchmarks.Sqlite, tx), tx.Rollback
+			})},
+			{Name: "database/sql update case", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.StandardUpdateCase(addresses[0:lim], sqlhbenchmarks.Sqlite, tx), tx.Rollback
+			})},
+			// {Name: "GORM slice+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+			// 	reset(b)
+			// 	tx := gb.Begin()
+			// 	return sqlhbenchmarks.GORMPreparedUpdate(addresses[0:lim], tx), func() error { return tx.Rollback().Error }
+			// })},
+			{Name: "sqlh/model update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+			{Name: "sqlh/model begin+prepare+update", Test: sqlhbenchmarks.EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+				tx := begin(b)
+				return sqlhbenchmarks.ModelPreparedUpdate(mdb, addresses[0:lim], tx), tx.Rollback
+			})},
+		})
 	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
 
 func BenchmarkSqlmockSelect(b *testing.B) {
@@ -21,10 +22,125 @@ func BenchmarkSqlmockSelect(b *testing.B) {
 		1000,
 		10000,
 	}
+	order := sqlhbenchmarks.NewOrder(b)
 	for _, limit := range limits {
-		b.Run(fmt.Sprintf("database/sql %v rows", limit), sqlhbenchmarks.StandardSelectSqlmock(limit, mock, db))
-		b.Run(fmt.Sprintf("sqlx %v rows", limit), sqlhbenchmarks.SqlxSelectSqlmock(limit, mock, db))
-		b.Run(fmt.Sprintf("scany %v rows", limit), sqlhbenchmarks.ScanySelectSqlmock(limit, mock, db))
-		b.Run(fmt.Sprintf("sqlh %v rows", limit), sqlhbenchmarks.SqlhSelectSqlmock(limit, mock, db))
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectSqlmock(limit, mock, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectSqlmock(limit, mock, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectSqlmock(limit, mock, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectSqlmock(limit, mock, db)},
+		})
+	}
+}
+
+func BenchmarkSqlmockSelectWide(b *testing.B) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		b.Fatalf("creating sqlmock with %v", err.Error())
+	}
+	widths := []int{
+		50,
+		100,
+		200,
+	}
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, columns := range widths {
+		for _, limit := range limits {
+			sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v columns %v rows", columns, limit), sqlhbenchmarks.Contenders{
+				{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectWideSqlmock(columns, limit, mock, db)},
+				{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectWideSqlmock(columns, limit, mock, db)},
+				{Name: "scany", Test: sqlhbenchmarks.ScanySelectWideSqlmock(columns, limit, mock, db)},
+				{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectWideSqlmock(columns, limit, mock, db)},
+			})
+		}
+	}
+}
+
+func BenchmarkSqlmockSelectNested(b *testing.B) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		b.Fatalf("creating sqlmock with %v", err.Error())
+	}
+	embeds := []struct {
+		name     string
+		pointers bool
+	}{
+		{"values", false},
+		{"pointers", true},
+	}
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, embed := range embeds {
+		for _, limit := range limits {
+			sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v rows", embed.name, limit), sqlhbenchmarks.Contenders{
+				{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectNestedSqlmock(embed.pointers, limit, mock, db)},
+				{Name: "scany", Test: sqlhbenchmarks.ScanySelectNestedSqlmock(embed.pointers, limit, mock, db)},
+				{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectNestedSqlmock(embed.pointers, limit, mock, db)},
+			})
+		}
+	}
+}
+
+func BenchmarkSqlmockSelectNullable(b *testing.B) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		b.Fatalf("creating sqlmock with %v", err.Error())
+	}
+	kinds := []string{
+		types.NullablePtr,
+		types.NullableSql,
+	}
+	limits := []int{
+		100,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, ratio := range sqlhbenchmarks.NullRatios(b) {
+		for _, kind := range kinds {
+			for _, limit := range limits {
+				sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v %v%% null %v rows", kind, sqlhbenchmarks.NullPercent(ratio), limit), sqlhbenchmarks.Contenders{
+					{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectNullableSqlmock(kind, ratio, limit, mock, db)},
+					{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectNullableSqlmock(kind, ratio, limit, mock, db)},
+					{Name: "scany", Test: sqlhbenchmarks.ScanySelectNullableSqlmock(kind, ratio, limit, mock, db)},
+					{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectNullableSqlmock(kind, ratio, limit, mock, db)},
+				})
+			}
+		}
+	}
+}
+
+func BenchmarkSqlmockSelectKitchenSink(b *testing.B) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		b.Fatalf("creating sqlmock with %v", err.Error())
+	}
+	limits := []int{
+		5,
+		50,
+		100,
+		500,
+		1000,
+	}
+	order := sqlhbenchmarks.NewOrder(b)
+	for _, limit := range limits {
+		sqlhbenchmarks.RunContenders(b, order, fmt.Sprintf("%v rows", limit), sqlhbenchmarks.Contenders{
+			{Name: "database/sql", Test: sqlhbenchmarks.StandardSelectKitchenSinkSqlmock(limit, mock, db)},
+			{Name: "sqlx", Test: sqlhbenchmarks.SqlxSelectKitchenSinkSqlmock(limit, mock, db)},
+			{Name: "scany", Test: sqlhbenchmarks.ScanySelectKitchenSinkSqlmock(limit, mock, db)},
+			{Name: "sqlh", Test: sqlhbenchmarks.SqlhSelectKitchenSinkSqlmock(limit, mock, db)},
+		})
 	}
 }
@@ -0,0 +1,74 @@
+package sqlhbenchmarks
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// coldTypes counts the types returned by NewColdType.
+var coldTypes int
+
+// NewColdType returns a struct type with the exported fields and tags of types.Address that no library
+// has scanned before.  Each call returns a distinct type because the tag of the first field carries the
+// number of types created so far.  The runtime never frees types made by reflect.StructOf, so every call
+// grows memory for the life of the process.
+func NewColdType() reflect.Type {
+	coldTypes++
+	address := reflect.TypeOf(types.Address{})
+	fields := []reflect.StructField{}
+	for k := 0; k < address.NumField(); k++ {
+		if field := address.Field(k); field.PkgPath == "" {
+			fields = append(fields, reflect.StructField{Name: field.Name, Type: field.Type, Tag: field.Tag})
+		}
+	}
+	fields[0].Tag += reflect.StructTag(fmt.Sprintf(` cold:"%v"`, coldTypes))
+	return reflect.StructOf(fields)
+}
+
+// ColdSelect selects query into dest, which is the address of a slice of pointers to a struct.
+type ColdSelect func(dest interface{}, query string) error
+
+// ColdSelector returns a ColdSelect with its own scanner, mapper, or DB so the caches of the library start
+// empty; the ColdSelect keeps its caches between calls.
+type ColdSelector func() ColdSelect
+
+// ColdStart creates a test where every operation creates a NewColdType and a ColdSelect from selector and
+// then selects limit rows into the type twice.  The first select pays for the reflection on the type and
+// its time is reported as first-ns/op; the second finds the caches warm and its time is reported as
+// steady-ns/op.  Every operation leaks one type (NewColdType) so a long -benchtime grows the heap of the
+// benchmark binary; prefer a fixed count such as -benchtime 1000x.
+func ColdStart(selector ColdSelector, limit int) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var first, steady time.Duration
+		var started time.Time
+		//
+		query := AddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			slice := reflect.SliceOf(reflect.PtrTo(NewColdType()))
+			cold, warm := reflect.New(slice).Interface(), reflect.New(slice).Interface()
+			b.StartTimer()
+			//
+			sel := selector()
+			started = time.Now()
+			if err = sel(cold, query); err != nil {
+				b.Fatalf("first select failed with %v", err.Error())
+			}
+			first += time.Since(started)
+			//
+			started = time.Now()
+			if err = sel(warm, query); err != nil {
+				b.Fatalf("steady select failed with %v", err.Error())
+			}
+			steady += time.Since(started)
+		}
+		b.ReportMetric(float64(first.Nanoseconds())/float64(b.N), "first-ns/op")
+		b.ReportMetric(float64(steady.Nanoseconds())/float64(b.N), "steady-ns/op")
+	}
+	return fn
+}
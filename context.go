@@ -0,0 +1,141 @@
+package sqlhbenchmarks
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// ContextTimeout is the deadline given to each statement by the context benchmarks; it is long enough that
+// it never expires.
+const ContextTimeout = 30 * time.Second
+
+// CancelAfterRows is the row during whose scan the cancellation benchmarks cancel their context.
+const CancelAfterRows = 1000
+
+// CancelAddressQuery returns the query that selects limit rows from the address table as
+// types.CancelAddress.
+func CancelAddressQuery(limit int) string {
+	return fmt.Sprintf(
+		"select %v, 0 as cancel_trigger from %v order by pk limit %v",
+		strings.Join(types.CancelAddressColumns[0:len(types.CancelAddressColumns)-1], ", "), types.AddressTableName, limit,
+	)
+}
+
+// queriesContext is implemented by *sql.DB and *sql.Tx.
+type queriesContext interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// contextQueries runs queries with ctx as sqlh.IQueries; sqlh v0.1.0 does not accept a context so one is
+// bound to the connection instead.
+type contextQueries struct {
+	ctx context.Context
+	q   queriesContext
+}
+
+func (me contextQueries) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return me.q.ExecContext(me.ctx, query, args...)
+}
+
+func (me contextQueries) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return me.q.QueryContext(me.ctx, query, args...)
+}
+
+func (me contextQueries) QueryRow(query string, args ...interface{}) *sql.Row {
+	return me.q.QueryRowContext(me.ctx, query, args...)
+}
+
+// contextBeginner is contextQueries that also begins transactions with ctx; sqlh inserts a slice in a
+// transaction it begins itself when given a sqlh.IBegins, so the transaction and every statement in it are
+// bound to ctx.
+type contextBeginner struct {
+	contextQueries
+	db *sql.DB
+}
+
+func (me contextBeginner) Begin() (*sql.Tx, error) {
+	return me.db.BeginTx(me.ctx, nil)
+}
+
+// stmtContext runs a prepared statement as queriesContext; like preparedQueries the query strings are ignored.
+type stmtContext struct {
+	stmt *sql.Stmt
+}
+
+func (me stmtContext) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return me.stmt.ExecContext(ctx, args...)
+}
+
+func (me stmtContext) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return me.stmt.QueryContext(ctx, args...)
+}
+
+func (me stmtContext) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return me.stmt.QueryRowContext(ctx, args...)
+}
+
+// ContextSelector selects CancelAddressQuery(limit) into dest with ctx.
+type ContextSelector func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error
+
+// CancelSelect creates a test that selects limit rows with selector and cancels the context while the
+// CancelAfterRows-th row is scanned.  The time from the cancel to the return of selector is reported as
+// cancel-ns/op and the fraction of errors for which errors.Is(err, context.Canceled) holds is reported as
+// is-canceled.  The test fails if selector returns no error or leaves a connection in use.
+//
+// unwraps is whether the library's errors unwrap to context.Canceled; the test fails when errors.Is does not
+// agree with it.  Pass false only for libraries that honor the context but do not wrap errors with %w (sqlh
+// v0.1.0); their error must still mention context.Canceled.
+func CancelSelect(selector ContextSelector, unwraps bool, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.CancelAddress
+		var canceled time.Time
+		var latency time.Duration
+		var is int
+		//
+		defer types.SetCancelTrigger(0, nil)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			canceled = time.Time{}
+			ctx, cancel := context.WithCancel(context.Background())
+			types.SetCancelTrigger(CancelAfterRows, func() {
+				canceled = time.Now()
+				cancel()
+			})
+			//
+			err = selector(ctx, limit, &dest)
+			latency += time.Since(canceled)
+			cancel()
+			//
+			if canceled.IsZero() {
+				b.Fatalf("select finished before row %v", CancelAfterRows)
+			} else if err == nil {
+				b.Fatalf("select returned %v rows and no error after cancel", len(dest))
+			} else if errors.Is(err, context.Canceled) {
+				if !unwraps {
+					b.Fatalf("errors.Is(err, context.Canceled) holds; pass unwraps as true")
+				}
+				is++
+			} else if unwraps {
+				b.Fatalf("select returned %v; expected context.Canceled", err.Error())
+			} else if !strings.Contains(err.Error(), context.Canceled.Error()) {
+				b.Fatalf("select returned %v; expected it to mention context.Canceled", err.Error())
+			}
+			if inUse := db.Stats().InUse; inUse != 0 {
+				b.Fatalf("%v connection(s) in use after cancel", inUse)
+			}
+		}
+		b.ReportMetric(float64(latency.Nanoseconds())/float64(b.N), "cancel-ns/op")
+		b.ReportMetric(float64(is)/float64(b.N), "is-canceled")
+	}
+	return fn
+}
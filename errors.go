@@ -0,0 +1,151 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+	"modernc.org/sqlite"
+)
+
+// ErrorCase names a failure benchmarked by the error path benchmarks.
+type ErrorCase string
+
+const (
+	// ErrorDuplicateKey inserts a row that violates a unique index.
+	ErrorDuplicateKey ErrorCase = "duplicate key"
+	// ErrorMissingRow updates a pk that does not exist.
+	ErrorMissingRow ErrorCase = "missing row"
+	// ErrorUnmappedColumn selects a column the destination has no field for.
+	ErrorUnmappedColumn ErrorCase = "unmapped column"
+	// ErrorTypeMismatch selects text into an integer field.
+	ErrorTypeMismatch ErrorCase = "type mismatch"
+	// ErrorEmptyGet selects a single row by a pk that does not exist.
+	ErrorEmptyGet ErrorCase = "empty get"
+)
+
+// ErrorCases are the error cases in the order they are benchmarked.
+var ErrorCases = []ErrorCase{
+	ErrorDuplicateKey, ErrorMissingRow, ErrorUnmappedColumn, ErrorTypeMismatch, ErrorEmptyGet,
+}
+
+// ErrorMissingPk is a pk that never exists in the address table.
+const ErrorMissingPk = -1
+
+// ErrorOps are the operations of one library keyed by the case they fail with; a library without an
+// operation for a case omits it.
+type ErrorOps map[ErrorCase]func() error
+
+// ErrorExpect is how an operation is expected to fail with its ErrorCase.
+type ErrorExpect string
+
+const (
+	// ErrorExpectWraps returns an error that passes the ErrorWraps check of the case; it is the default.
+	ErrorExpectWraps ErrorExpect = ""
+	// ErrorExpectUnwrapped returns an error that fails the ErrorWraps check because the library does not
+	// wrap the cause.
+	ErrorExpectUnwrapped ErrorExpect = "unwrapped"
+	// ErrorExpectNone returns no error.
+	ErrorExpectNone ErrorExpect = "none"
+)
+
+// ErrorDeviations are the cases a library deliberately fails differently than ErrorExpectWraps; cases not
+// in the map use ErrorExpectWraps.
+type ErrorDeviations map[ErrorCase]ErrorExpect
+
+// ErrorUniqueIndex creates a unique index on the street column of the address table so inserting a copy of
+// an address fails with ErrorDuplicateKey.
+func ErrorUniqueIndex(db *sql.DB) error {
+	_, err := db.Exec(fmt.Sprintf("create unique index %[1]v_street_uq on %[1]v ( street )", types.AddressTableName))
+	return err
+}
+
+// ErrorUnmappedQuery returns the query for ErrorUnmappedColumn.
+func ErrorUnmappedQuery() string {
+	return fmt.Sprintf(`
+		select
+			pk, created_tmz, modified_tmz,
+			street, city, state, zip, 'x' as unmapped
+		from %v
+		order by pk
+		limit 1
+	`, types.AddressTableName)
+}
+
+// ErrorTypeMismatchQuery returns the query for ErrorTypeMismatch.
+func ErrorTypeMismatchQuery() string {
+	return fmt.Sprintf(`
+		select
+			street as pk, created_tmz, modified_tmz,
+			street, city, state, zip
+		from %v
+		order by pk
+		limit 1
+	`, types.AddressTableName)
+}
+
+// ErrorWraps returns the check for whether an error of c carries its cause: the driver error for
+// ErrorDuplicateKey and sql.ErrNoRows for ErrorMissingRow and ErrorEmptyGet.  Scan errors have no cause to
+// carry so nil is returned for the other cases.
+func ErrorWraps(c ErrorCase, dialect Dialect) func(error) bool {
+	switch c {
+	case ErrorDuplicateKey:
+		if dialect == Postgres {
+			return func(err error) bool {
+				var target *pq.Error
+				return errors.As(err, &target)
+			}
+		}
+		return func(err error) bool {
+			var target *sqlite.Error
+			return errors.As(err, &target)
+		}
+
+	case ErrorMissingRow, ErrorEmptyGet:
+		return func(err error) bool {
+			return errors.Is(err, sql.ErrNoRows)
+		}
+	}
+	return nil
+}
+
+// ErrorPath creates a test that runs op, which is expected to fail with c as described by expect.  The
+// fraction of calls that return an error is reported as errored; when ErrorWraps has a check for c the
+// fraction of errors passing it is reported as wraps.  The test fails the first time op does not behave as
+// expect says.
+func ErrorPath(c ErrorCase, op func() error, expect ErrorExpect, dialect Dialect) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var errored, wraps int
+		check := ErrorWraps(c, dialect)
+		//
+		for k := 0; k < b.N; k++ {
+			if err = op(); err == nil {
+				if expect != ErrorExpectNone {
+					b.Fatalf("%v returned no error", c)
+				}
+				continue
+			} else if expect == ErrorExpectNone {
+				b.Fatalf("%v returned %v; expected no error", c, err.Error())
+			}
+			errored++
+			if check != nil && check(err) {
+				if expect == ErrorExpectUnwrapped {
+					b.Fatalf("%v returned %v which keeps its cause; expected it not to", c, err.Error())
+				}
+				wraps++
+			} else if check != nil && expect == ErrorExpectWraps {
+				b.Fatalf("%v returned %v which does not keep its cause", c, err.Error())
+			}
+		}
+		//
+		b.ReportMetric(float64(errored)/float64(b.N), "errored")
+		if check != nil {
+			b.ReportMetric(float64(wraps)/float64(b.N), "wraps")
+		}
+	}
+	return fn
+}
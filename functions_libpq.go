@@ -3,6 +3,7 @@ package sqlhbenchmarks
 import (
 	"database/sql"
 	"os"
+	"testing"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -16,8 +17,12 @@ import (
 )
 
 // ConnectLibpq connects to postgresql using lib/pq if the TEST_POSTGRES environment variable is set.
-func ConnectLibpq() (SkipReason string, DB *sql.DB, GB *gorm.DB, err error) {
+//
+// The tables for models are dropped and created with the names given by TableSuffix; when tb finishes
+// the tables are dropped and the connections closed.
+func ConnectLibpq(tb testing.TB, models ...string) (SkipReason string, DB *sql.DB, GB *gorm.DB, err error) {
 	env := "TEST_POSTGRES"
+	var suffix string
 	//
 	// Extra for GORM.
 	var gdb *sql.DB
@@ -28,7 +33,28 @@ func ConnectLibpq() (SkipReason string, DB *sql.DB, GB *gorm.DB, err error) {
 	dsn := os.Getenv(env)
 	if dsn == "" {
 		SkipReason = env + " environment variable is empty"
-	} else if DB, err = sql.Open("postgres", dsn); err != nil {
+		return
+	} else if suffix, err = TableSuffix(); err != nil {
+		return
+	}
+	types.SetTableSuffix(suffix)
+	types.SetTimeEncoding(TimeEncodings[Postgres])
+	defer func() {
+		if DB == nil {
+			return
+		}
+		tb.Cleanup(func() {
+			if err := ExecSchema(DB, Postgres, StepDrop, models...); err != nil {
+				tb.Logf("dropping tables failed with %v", err.Error())
+			}
+			if gdb != nil {
+				gdb.Close()
+			}
+			DB.Close()
+		})
+	}()
+	//
+	if DB, err = sql.Open("postgres", dsn); err != nil {
 		return
 	} else if GB, err = gorm.Open(postgres.Open(dsn), gcfg); err != nil {
 		return
@@ -38,7 +64,7 @@ func ConnectLibpq() (SkipReason string, DB *sql.DB, GB *gorm.DB, err error) {
 		return
 	} else if err = gdb.Ping(); err != nil {
 		return
-	} else if err = ExecSchema(SchemaLibpq, DB); err != nil {
+	} else if err = ExecSchema(DB, Postgres, StepReset, models...); err != nil {
 		return
 	}
 	return
@@ -46,7 +72,7 @@ func ConnectLibpq() (SkipReason string, DB *sql.DB, GB *gorm.DB, err error) {
 
 // LibpqModels returns all the models and types for our tests.
 func LibpqModels() (Addresses []*types.Address, Mdb *model.Models, err error) {
-	Addresses = types.AddressRecords
+	Addresses = types.AddressRecords()
 	//
 	Mdb = types.NewModels(grammar.Postgres)
 	if Mdb == nil {
@@ -3,6 +3,7 @@ package sqlhbenchmarks
 import (
 	"database/sql"
 	"os"
+	"testing"
 
 	"github.com/nofeaturesonlybugs/errors"
 	"github.com/nofeaturesonlybugs/sqlh/grammar"
@@ -18,8 +19,12 @@ import (
 )
 
 // ConnectSqlite connects to sqlite using modernc.org/sqlite if the TEST_SQLITE environment variable is set.
-func ConnectSqlite() (SkipReason string, DB *sql.DB /*GB *gorm.DB,*/, err error) {
+//
+// The tables for models are dropped and created with the names given by TableSuffix; when tb finishes
+// the tables are dropped and the connection closed.
+func ConnectSqlite(tb testing.TB, models ...string) (SkipReason string, DB *sql.DB /*GB *gorm.DB,*/, err error) {
 	env := "TEST_SQLITE"
+	var suffix string
 	//
 	// Extra for GORM.
 	// var gdb *sql.DB
@@ -30,7 +35,25 @@ func ConnectSqlite() (SkipReason string, DB *sql.DB /*GB *gorm.DB,*/, err error)
 	dsn := os.Getenv(env)
 	if dsn == "" {
 		SkipReason = env + " environment variable is empty"
-	} else if DB, err = sql.Open("sqlite", dsn); err != nil {
+		return
+	} else if suffix, err = TableSuffix(); err != nil {
+		return
+	}
+	types.SetTableSuffix(suffix)
+	types.SetTimeEncoding(TimeEncodings[Sqlite])
+	defer func() {
+		if DB == nil {
+			return
+		}
+		tb.Cleanup(func() {
+			if err := ExecSchema(DB, Sqlite, StepDrop, models...); err != nil {
+				tb.Logf("dropping tables failed with %v", err.Error())
+			}
+			DB.Close()
+		})
+	}()
+	//
+	if DB, err = sql.Open("sqlite", dsn); err != nil {
 		return
 		// } else if GB, err = gorm.Open(sqlite.Open(dsn), gcfg); err != nil {
 		// 	return
@@ -40,7 +63,7 @@ func ConnectSqlite() (SkipReason string, DB *sql.DB /*GB *gorm.DB,*/, err error)
 		// 	return
 		// } else if err = gdb.Ping(); err != nil {
 		// 	return
-	} else if err = ExecSchema(SchemaSqlite, DB); err != nil {
+	} else if err = ExecSchema(DB, Sqlite, StepReset, models...); err != nil {
 		return
 	}
 	return
@@ -48,7 +71,7 @@ func ConnectSqlite() (SkipReason string, DB *sql.DB /*GB *gorm.DB,*/, err error)
 
 // SqliteModels returns all the models and types for our tests.
 func SqliteModels() (Addresses []*types.Address, Mdb *model.Models, err error) {
-	Addresses = types.AddressRecords
+	Addresses = types.AddressRecords()
 	//
 	Mdb = types.NewModels(grammar.Default)
 	if Mdb == nil {
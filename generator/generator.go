@@ -0,0 +1,253 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// Range is an inclusive range of string lengths.
+type Range struct {
+	Min, Max int
+}
+
+// Kind selects how a Distribution draws its values.
+type Kind int
+
+const (
+	// Uniform draws every value in the range with equal probability.
+	Uniform Kind = iota
+	// Normal draws values around the midpoint of the range; Min and Max are three standard deviations
+	// from the midpoint and values beyond them are clamped.
+	Normal
+	// Zipf draws values skewed heavily toward Min; useful for foreign keys where a few customers or
+	// vendors account for most of the rows.
+	Zipf
+)
+
+// Distribution describes how integers are drawn from the inclusive range Min to Max.
+type Distribution struct {
+	Kind     Kind
+	Min, Max int
+}
+
+// Config controls the records created by a Generator.  Every member is used as given, so a Seed of 0 or a
+// Distribution of all zeros is honored; start from DefaultConfig and change the members of interest.
+type Config struct {
+	// Seed for the random source; generators with equal configs create equal records.
+	Seed int64
+	//
+	// Lengths of generated strings.
+	StreetLength      Range
+	CityLength        Range
+	NameLength        Range
+	VendorNameLength  Range
+	DescriptionLength Range
+	//
+	// Distributions of generated values.
+	Price     Distribution
+	Quantity  Distribution
+	Customers Distribution
+	Vendors   Distribution
+	// Days is the offset from January 1st, 2020 of SaleReport.CreatedTime; SaleReport.ModifiedTime is
+	// a second draw of Days after CreatedTime.
+	Days Distribution
+}
+
+// DefaultConfig is modeled on the records in package data.
+var DefaultConfig = Config{
+	Seed:              1,
+	StreetLength:      Range{Min: 10, Max: 27},
+	CityLength:        Range{Min: 4, Max: 17},
+	NameLength:        Range{Min: 3, Max: 9},
+	VendorNameLength:  Range{Min: 6, Max: 36},
+	DescriptionLength: Range{Min: 2, Max: 75},
+	Price:             Distribution{Kind: Uniform, Min: 1, Max: 10},
+	Quantity:          Distribution{Kind: Uniform, Min: 1, Max: 10},
+	Customers:         Distribution{Kind: Uniform, Min: 1, Max: 100},
+	Vendors:           Distribution{Kind: Uniform, Min: 1, Max: 100},
+	Days:              Distribution{Kind: Uniform, Min: 0, Max: 730},
+}
+
+// epoch is the date SaleReport dates are offset from.
+var epoch = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// Generator creates synthetic records.  A Generator is not safe for concurrent use.
+type Generator struct {
+	Config
+	rng    *rand.Rand
+	zipfs  map[Distribution]*rand.Zipf
+	saleId int
+	//
+	// Customers and vendors are created once per id so every row referencing an id agrees on its names.
+	customers map[int]*types.SaleReport
+	vendors   map[int]*types.SaleReport
+}
+
+// New creates a Generator for config.
+func New(config Config) *Generator {
+	rv := &Generator{
+		Config:    config,
+		rng:       rand.New(rand.NewSource(config.Seed)),
+		zipfs:     map[Distribution]*rand.Zipf{},
+		customers: map[int]*types.SaleReport{},
+		vendors:   map[int]*types.SaleReport{},
+	}
+	return rv
+}
+
+// Addresses creates n addresses ready to be inserted; their Id and time members are zero.
+func (me *Generator) Addresses(n int) []*types.Address {
+	rv := make([]*types.Address, n)
+	for k := range rv {
+		rv[k] = &types.Address{
+			Street: me.Street(me.Length(me.StreetLength)),
+			City:   me.Name(me.Length(me.CityLength)),
+			State:  states[me.rng.Intn(len(states))],
+			Zip:    fmt.Sprintf("%05d", me.rng.Intn(100000)),
+		}
+	}
+	return rv
+}
+
+// SaleReports creates n sale reports.  Ids continue from the previous call.
+func (me *Generator) SaleReports(n int) []*types.SaleReport {
+	rv := make([]*types.SaleReport, n)
+	for k := range rv {
+		me.saleId++
+		created := epoch.AddDate(0, 0, me.Int(me.Days))
+		modified := created.AddDate(0, 0, me.Int(me.Days)-me.Days.Min)
+		customer, vendor := me.customer(me.Int(me.Customers)), me.vendor(me.Int(me.Vendors))
+		price, quantity := me.Int(me.Price), me.Int(me.Quantity)
+		rv[k] = &types.SaleReport{
+			Id:                 me.saleId,
+			CreatedTime:        created.Format("2006-01-02"),
+			ModifiedTime:       modified.Format("2006-01-02"),
+			Price:              price,
+			Quantity:           quantity,
+			Total:              price * quantity,
+			CustomerId:         customer.CustomerId,
+			CustomerFirst:      customer.CustomerFirst,
+			CustomerLast:       customer.CustomerLast,
+			VendorId:           vendor.VendorId,
+			VendorName:         vendor.VendorName,
+			VendorDescription:  vendor.VendorDescription,
+			VendorContactId:    vendor.VendorContactId,
+			VendorContactFirst: vendor.VendorContactFirst,
+			VendorContactLast:  vendor.VendorContactLast,
+		}
+	}
+	return rv
+}
+
+// customer returns the customer members for id.
+func (me *Generator) customer(id int) *types.SaleReport {
+	if rv, ok := me.customers[id]; ok {
+		return rv
+	}
+	rv := &types.SaleReport{
+		CustomerId:    id,
+		CustomerFirst: me.Name(me.Length(me.NameLength)),
+		CustomerLast:  me.Name(me.Length(me.NameLength)),
+	}
+	me.customers[id] = rv
+	return rv
+}
+
+// vendor returns the vendor and vendor contact members for id; every vendor has a single contact
+// sharing its id.
+func (me *Generator) vendor(id int) *types.SaleReport {
+	if rv, ok := me.vendors[id]; ok {
+		return rv
+	}
+	suffix := companySuffixes[me.rng.Intn(len(companySuffixes))]
+	rv := &types.SaleReport{
+		VendorId:           id,
+		VendorName:         me.Name(me.Length(me.VendorNameLength)-len(suffix)-1) + " " + suffix,
+		VendorDescription:  me.Text(me.Length(me.DescriptionLength)),
+		VendorContactId:    id,
+		VendorContactFirst: me.Name(me.Length(me.NameLength)),
+		VendorContactLast:  me.Name(me.Length(me.NameLength)),
+	}
+	me.vendors[id] = rv
+	return rv
+}
+
+// Int draws an integer from d.
+func (me *Generator) Int(d Distribution) int {
+	if d.Max <= d.Min {
+		return d.Min
+	}
+	switch d.Kind {
+	case Normal:
+		mid, stddev := float64(d.Min+d.Max)/2, float64(d.Max-d.Min)/6
+		v := int(math.Round(mid + me.rng.NormFloat64()*stddev))
+		if v < d.Min {
+			v = d.Min
+		} else if v > d.Max {
+			v = d.Max
+		}
+		return v
+
+	case Zipf:
+		z, ok := me.zipfs[d]
+		if !ok {
+			z = rand.NewZipf(me.rng, 1.1, 1, uint64(d.Max-d.Min))
+			me.zipfs[d] = z
+		}
+		return d.Min + int(z.Uint64())
+
+	default:
+		return d.Min + me.rng.Intn(d.Max-d.Min+1)
+	}
+}
+
+// Length draws a string length from r.
+func (me *Generator) Length(r Range) int {
+	return me.Int(Distribution{Kind: Uniform, Min: r.Min, Max: r.Max})
+}
+
+// Name creates a capitalized name of length characters, at least one, from syllables.
+func (me *Generator) Name(length int) string {
+	if length < 1 {
+		length = 1
+	}
+	var b strings.Builder
+	for b.Len() < length {
+		b.WriteString(syllables[me.rng.Intn(len(syllables))])
+	}
+	rv := b.String()[0:length]
+	return strings.ToUpper(rv[0:1]) + rv[1:]
+}
+
+// Text creates free text of up to length characters, at least one, from words.
+func (me *Generator) Text(length int) string {
+	if length < 1 {
+		length = 1
+	}
+	var b strings.Builder
+	for b.Len() < length {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(words[me.rng.Intn(len(words))])
+	}
+	rv := strings.TrimRight(b.String()[0:length], " ")
+	return strings.ToUpper(rv[0:1]) + rv[1:]
+}
+
+// Street creates a street address of roughly length characters: a house number, a name of at least three
+// characters, and a suffix.
+func (me *Generator) Street(length int) string {
+	number := fmt.Sprint(1 + me.rng.Intn(99999))
+	suffix := streetSuffixes[me.rng.Intn(len(streetSuffixes))]
+	name := length - len(number) - len(suffix) - 2
+	if name < 3 {
+		name = 3
+	}
+	return number + " " + me.Name(name) + " " + suffix
+}
@@ -0,0 +1,161 @@
+package generator_test
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/generator"
+)
+
+func TestDeterminism(t *testing.T) {
+	seeded := func(seed int64) generator.Config {
+		config := generator.DefaultConfig
+		config.Seed = seed
+		return config
+	}
+	tests := []struct {
+		Name  string
+		A, B  generator.Config
+		Equal bool
+	}{
+		{"default", generator.DefaultConfig, generator.DefaultConfig, true},
+		{"seed 0", seeded(0), seeded(0), true},
+		{"seed 42", seeded(42), seeded(42), true},
+		{"seed 0 vs 1", seeded(0), seeded(1), false},
+		{"seed 1 vs 2", seeded(1), seeded(2), false},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			a, b := generator.New(test.A), generator.New(test.B)
+			if got := reflect.DeepEqual(a.Addresses(100), b.Addresses(100)); got != test.Equal {
+				t.Errorf("addresses equal is %v; expected %v", got, test.Equal)
+			}
+			if got := reflect.DeepEqual(a.SaleReports(100), b.SaleReports(100)); got != test.Equal {
+				t.Errorf("sale reports equal is %v; expected %v", got, test.Equal)
+			}
+		})
+	}
+}
+
+func TestDistribution(t *testing.T) {
+	const draws = 20000
+	tests := []struct {
+		Name         string
+		Distribution generator.Distribution
+		// Mean is the expected mean within Tolerance.
+		Mean, Tolerance float64
+		// Check is an extra check of the sorted draws.
+		Check func(sorted []int) string
+	}{
+		{
+			Name:         "zero",
+			Distribution: generator.Distribution{},
+			Mean:         0, Tolerance: 0,
+		},
+		{
+			Name:         "single value",
+			Distribution: generator.Distribution{Kind: generator.Normal, Min: 7, Max: 7},
+			Mean:         7, Tolerance: 0,
+		},
+		{
+			Name:         "uniform",
+			Distribution: generator.Distribution{Kind: generator.Uniform, Min: 1, Max: 10},
+			Mean:         5.5, Tolerance: 0.1,
+			Check: func(sorted []int) string {
+				if sorted[0] != 1 || sorted[len(sorted)-1] != 10 {
+					return "uniform draws do not reach both ends of the range"
+				}
+				return ""
+			},
+		},
+		{
+			Name:         "normal",
+			Distribution: generator.Distribution{Kind: generator.Normal, Min: 0, Max: 600},
+			Mean:         300, Tolerance: 5,
+			Check: func(sorted []int) string {
+				// Within one standard deviation (100) of the midpoint holds about 68% of the draws.
+				var within int
+				for _, v := range sorted {
+					if v >= 200 && v <= 400 {
+						within++
+					}
+				}
+				if fraction := float64(within) / float64(len(sorted)); fraction < 0.64 || fraction > 0.72 {
+					return "normal draws within one standard deviation are not about 68%"
+				}
+				return ""
+			},
+		},
+		{
+			Name:         "zipf",
+			Distribution: generator.Distribution{Kind: generator.Zipf, Min: 1, Max: 100},
+			Mean:         -1,
+			Check: func(sorted []int) string {
+				if median := sorted[len(sorted)/2]; median > 5 {
+					return "zipf draws are not skewed toward Min"
+				}
+				return ""
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			g := generator.New(generator.DefaultConfig)
+			sorted := make([]int, draws)
+			var sum float64
+			for k := range sorted {
+				v := g.Int(test.Distribution)
+				if v < test.Distribution.Min || v > test.Distribution.Max {
+					t.Fatalf("draw %v outside %v to %v", v, test.Distribution.Min, test.Distribution.Max)
+				}
+				sorted[k] = v
+				sum += float64(v)
+			}
+			sort.Ints(sorted)
+			if mean := sum / draws; test.Mean >= 0 && math.Abs(mean-test.Mean) > test.Tolerance {
+				t.Errorf("mean is %v; expected %v within %v", mean, test.Mean, test.Tolerance)
+			}
+			if test.Check != nil {
+				if msg := test.Check(sorted); msg != "" {
+					t.Errorf("%v", msg)
+				}
+			}
+		})
+	}
+}
+
+func TestLengths(t *testing.T) {
+	config := generator.DefaultConfig
+	config.CityLength = generator.Range{Min: 5, Max: 8}
+	config.NameLength = generator.Range{Min: 3, Max: 3}
+	g := generator.New(config)
+	//
+	for _, address := range g.Addresses(1000) {
+		if n := len(address.City); n < 5 || n > 8 {
+			t.Fatalf("city %q has length %v; expected 5 to 8", address.City, n)
+		}
+	}
+	for _, report := range g.SaleReports(1000) {
+		if len(report.CustomerFirst) != 3 || len(report.CustomerLast) != 3 {
+			t.Fatalf("customer %q %q; expected names of length 3", report.CustomerFirst, report.CustomerLast)
+		}
+		if report.Total != report.Price*report.Quantity {
+			t.Fatalf("total %v is not price %v times quantity %v", report.Total, report.Price, report.Quantity)
+		}
+	}
+}
+
+func TestZeroConfig(t *testing.T) {
+	g := generator.New(generator.Config{})
+	if addresses := g.Addresses(10); len(addresses) != 10 {
+		t.Fatalf("created %v addresses; expected 10", len(addresses))
+	}
+	for _, report := range g.SaleReports(10) {
+		if report.CustomerId != 0 || report.VendorId != 0 || report.Price != 0 || report.Quantity != 0 {
+			t.Fatalf("zero distributions drew customer %v, vendor %v, price %v, quantity %v; expected zeros",
+				report.CustomerId, report.VendorId, report.Price, report.Quantity)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package generator produces any number of seeded, synthetic records for the types in package types
+// so benchmarks are not limited by the size of the datasets in package data.
+package generator
@@ -0,0 +1,42 @@
+package generator
+
+// words is the pool free text is built from.
+var words = []string{
+	"lorem", "ipsum", "dolor", "sit", "amet", "consectetuer", "adipiscing", "elit", "sed", "diam",
+	"nonummy", "nibh", "euismod", "tincidunt", "ut", "laoreet", "dolore", "magna", "aliquam", "erat",
+	"volutpat", "wisi", "enim", "ad", "minim", "veniam", "quis", "nostrud", "exerci", "tation",
+	"ullamcorper", "suscipit", "lobortis", "nisl", "aliquip", "ex", "ea", "commodo", "consequat", "duis",
+	"autem", "vel", "eum", "iriure", "in", "hendrerit", "vulputate", "velit", "esse", "molestie",
+	"nunc", "mauris", "sapien", "cursus", "egestas", "ligula", "nullam", "feugiat", "placerat", "quisque",
+	"varius", "ante", "blandit", "viverra", "donec", "pede", "nec", "per", "conubia", "nostra",
+}
+
+// syllables are joined to build names.
+var syllables = []string{
+	"al", "an", "ar", "bel", "bur", "ca", "cor", "da", "del", "dor", "el", "em", "fa", "fer", "ga",
+	"gil", "ha", "har", "is", "ja", "ka", "kel", "la", "lar", "ma", "mar", "mi", "na", "nel", "o",
+	"or", "pa", "per", "ra", "ri", "ro", "sa", "ser", "ta", "ton", "u", "va", "ver", "wa", "wil", "yo",
+}
+
+// streetSuffixes end generated street names.
+var streetSuffixes = []string{
+	"Alley", "Avenue", "Center", "Circle", "Court", "Crossing", "Drive", "Junction", "Lane", "Park",
+	"Parkway", "Pass", "Place", "Plaza", "Point", "Road", "Street", "Terrace", "Trail", "Way",
+}
+
+// states are the names generated addresses are located in.
+var states = []string{
+	"Alabama", "Alaska", "Arizona", "Arkansas", "California", "Colorado", "Connecticut", "Delaware",
+	"Florida", "Georgia", "Hawaii", "Idaho", "Illinois", "Indiana", "Iowa", "Kansas", "Kentucky",
+	"Louisiana", "Maine", "Maryland", "Massachusetts", "Michigan", "Minnesota", "Mississippi", "Missouri",
+	"Montana", "Nebraska", "Nevada", "New Hampshire", "New Jersey", "New Mexico", "New York",
+	"North Carolina", "North Dakota", "Ohio", "Oklahoma", "Oregon", "Pennsylvania", "Rhode Island",
+	"South Carolina", "South Dakota", "Tennessee", "Texas", "Utah", "Vermont", "Virginia", "Washington",
+	"West Virginia", "Wisconsin", "Wyoming",
+}
+
+// companySuffixes end generated vendor names.
+var companySuffixes = []string{
+	"Associates", "Company", "Corp.", "Corporation", "Foundation", "Inc.", "Industries", "Institute",
+	"LLC", "LLP", "Limited", "PC",
+}
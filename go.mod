@@ -1,6 +1,6 @@
 module github.com/nofeaturesonlybugs/sqlhbenchmarks
 
-go 1.14
+go 1.16
 
 require (
 	github.com/DATA-DOG/go-sqlmock v1.5.0
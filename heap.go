@@ -0,0 +1,49 @@
+package sqlhbenchmarks
+
+import (
+	"runtime"
+	"testing"
+)
+
+// HeapSampleRows is how many rows streaming benchmarks scan between calls to HeapPeak.Sample.
+const HeapSampleRows = 4096
+
+// HeapPeak records the peak of runtime.MemStats.HeapAlloc during a benchmark and reports it as the
+// peak-heap-B metric next to ns/op.  Sampling stops the world so it is done with the timer stopped and only
+// every HeapSampleRows rows.
+type HeapPeak struct {
+	b     *testing.B
+	peak  uint64
+	stats runtime.MemStats
+}
+
+// NewHeapPeak collects garbage left by earlier benchmarks and creates a HeapPeak for b.
+func NewHeapPeak(b *testing.B) *HeapPeak {
+	b.StopTimer()
+	defer b.StartTimer()
+	runtime.GC()
+	rv := &HeapPeak{b: b}
+	rv.sample()
+	return rv
+}
+
+// Sample reads the current heap size and keeps it if it is the largest so far.
+func (me *HeapPeak) Sample() {
+	me.b.StopTimer()
+	me.sample()
+	me.b.StartTimer()
+}
+
+// sample is Sample without stopping the timer.
+func (me *HeapPeak) sample() {
+	runtime.ReadMemStats(&me.stats)
+	if me.stats.HeapAlloc > me.peak {
+		me.peak = me.stats.HeapAlloc
+	}
+}
+
+// Report samples the heap once more and reports the peak.
+func (me *HeapPeak) Report() {
+	me.Sample()
+	me.b.ReportMetric(float64(me.peak), "peak-heap-B")
+}
@@ -0,0 +1,75 @@
+// Command widegen writes the wide struct types used by the wide row benchmarks.
+//
+// Usage:
+//
+//	go run ./internal/widegen -out types/wide_gen.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+)
+
+// Widths are the column counts a type is generated for.
+var Widths = []int{50, 100, 200}
+
+// Kinds are the Go types of the columns; column k has type Kinds[k%len(Kinds)] and must match
+// types.WideKind.
+var Kinds = []string{"int", "string", "float64", "bool"}
+
+func main() {
+	out := flag.String("out", "wide_gen.go", "output file")
+	flag.Parse()
+	//
+	buf := &bytes.Buffer{}
+	p := func(format string, args ...interface{}) {
+		fmt.Fprintf(buf, format, args...)
+	}
+	p("// Code generated by internal/widegen; DO NOT EDIT.\n\n")
+	p("package types\n\n")
+	p("// Wide table names; see SetTableSuffix.\n")
+	p("var (\n")
+	for _, width := range Widths {
+		p("\tWide%vTableName = \"sqlh_wide%v\"\n", width, width)
+	}
+	p(")\n\n")
+	p("func init() {\n")
+	for _, width := range Widths {
+		p("\ttableNames[\"sqlh_wide%v\"] = &Wide%vTableName\n", width, width)
+		p("\tWideTypes[%v] = WideType{\n", width)
+		p("\t\tTableName: &Wide%vTableName,\n", width)
+		p("\t\tNew: func() Wide { return &Wide%v{} },\n", width)
+		p("\t\tNewSlice: func() interface{} { return &[]*Wide%v{} },\n", width)
+		p("\t}\n")
+	}
+	p("}\n")
+	for _, width := range Widths {
+		p("\n// Wide%v is a SELECT destination with %v columns.\n", width, width)
+		p("type Wide%v struct {\n", width)
+		for k := 0; k < width; k++ {
+			p("\tC%03d %v `json:\"c%03d\" db:\"c%03d\"`\n", k, Kinds[k%len(Kinds)], k, k)
+		}
+		p("}\n\n")
+		p("// Pointers returns the addresses of the fields in column order.\n")
+		p("func (me *Wide%v) Pointers() []interface{} {\n", width)
+		p("\treturn []interface{}{\n")
+		for k := 0; k < width; k++ {
+			p("\t\t&me.C%03d,\n", k)
+		}
+		p("\t}\n}\n\n")
+		p("func (me *Wide%v) MockRows(n int) *sqlmock.Rows {\n", width)
+		p("\treturn wideMockRows(%v, n)\n}\n", width)
+	}
+	//
+	src, err := format.Source(bytes.Replace(buf.Bytes(), []byte("package types\n\n"), []byte("package types\n\nimport \"github.com/DATA-DOG/go-sqlmock\"\n\n"), 1))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,6 +1,7 @@
 package sqlhbenchmarks
 
 import (
+	"context"
 	"testing"
 
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
@@ -24,6 +25,121 @@ func GORMSelect(limit int, db *gorm.DB) func(*testing.B) {
 	return fn
 }
 
+// GORMSelectSales selects joined sales rows using GORM.
+func GORMSelectSales(limit int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.SaleReport
+		var result *gorm.DB
+		//
+		query := SaleReportQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			result = db.Raw(query).Scan(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// GORMSelectNullable selects nullable rows using GORM; kind is one of types.NullablePtr or types.NullableSql.
+func GORMSelectNullable(kind string, limit int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest interface{}
+		var result *gorm.DB
+		//
+		query := NullableAddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			result = db.Raw(query).Scan(dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// GORMSelectKitchenSink selects rows of many column types using GORM.
+func GORMSelectKitchenSink(limit int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.KitchenSink
+		var result *gorm.DB
+		//
+		query := KitchenSinkQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			result = db.Raw(query).Scan(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// GORMLookup selects one address by pk using GORM First; each iteration looks up the next of ids.  When
+// prepared is true the lookups run in a session with PrepareStmt.
+func GORMLookup(ids []int, prepared bool, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var result *gorm.DB
+		//
+		sess := db
+		if prepared {
+			sess = db.Session(&gorm.Session{PrepareStmt: true})
+		}
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			d := types.Address{}
+			result = sess.First(&d, id)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			} else if d.Id != id {
+				b.Fatalf("gorm lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// GORMPreparedSelect selects records using GORM in a session with PrepareStmt.
+func GORMPreparedSelect(limit int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.Address
+		var result *gorm.DB
+		//
+		sess := db.Session(&gorm.Session{PrepareStmt: true})
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			result = sess.Order("pk").Limit(limit).Find(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// GORMSelectIn selects the addresses with pk in ids using GORM Where with a slice.
+func GORMSelectIn(ids []int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.Address
+		var result *gorm.DB
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			result = db.Where("pk IN ?", ids).Find(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			} else if len(dest) != len(ids) {
+				b.Fatalf("gorm selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
 // GORMInsert performs INSERTs using GORM.
 func GORMInsert(addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
 	fn := func(b *testing.B) {
@@ -70,6 +186,81 @@ func GORMPreparedInsert(addresses []*types.Address, db *gorm.DB) func(b *testing
 	return fn
 }
 
+// gormPostInsert is Address.PostInsert for GORM.  types.Address excludes its times from GORM so Create only
+// returns the pk; the check is that the pk was set and the other columns still hold the inserted values.
+func gormPostInsert(b *testing.B, inserted *types.Address, address *types.Address) {
+	if address.Id <= 0 {
+		b.Fatalf("%T.Id not updated", address)
+	} else if address.Street != inserted.Street || address.City != inserted.City ||
+		address.State != inserted.State || address.Zip != inserted.Zip {
+		b.Fatalf("%T changed from %+v to %+v", address, *inserted, *address)
+	}
+}
+
+// GORMTxInsert performs INSERTs using GORM while managing transactions as described by mode.
+func GORMTxInsert(mode TxMode, addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var tx *gorm.DB
+		var result *gorm.DB
+		//
+		begin := func() {
+			if tx = db.Begin(); tx.Error != nil {
+				b.Fatalf("error beginning transaction with %v", tx.Error.Error())
+			}
+		}
+		commit := func() {
+			if result = tx.Commit(); result.Error != nil {
+				b.Fatalf("error durring commit with %v", result.Error.Error())
+			}
+			tx = nil
+		}
+		//
+		for k := 0; k < b.N; k++ {
+			if mode.Savepoint {
+				begin()
+			}
+			for n, address := range addresses {
+				address.PreInsert(b)
+				inserted := *address
+				//
+				switch {
+				case mode.Savepoint:
+					if result = tx.SavePoint(TxSavepoint); result.Error != nil {
+						b.Fatalf("savepoint failed with %v", result.Error.Error())
+					}
+					if result = tx.Create(address); result.Error != nil {
+						b.Fatalf("gorm failed with %v", result.Error.Error())
+					}
+					if result = tx.Exec("release savepoint " + TxSavepoint); result.Error != nil {
+						b.Fatalf("release savepoint failed with %v", result.Error.Error())
+					}
+
+				case mode.Batch > 0:
+					if tx == nil {
+						begin()
+					}
+					if result = tx.Create(address); result.Error != nil {
+						b.Fatalf("gorm failed with %v", result.Error.Error())
+					}
+					if (n+1)%mode.Batch == 0 {
+						commit()
+					}
+
+				default:
+					if result = db.Create(address); result.Error != nil {
+						b.Fatalf("gorm failed with %v", result.Error.Error())
+					}
+				}
+				gormPostInsert(b, &inserted, address)
+			}
+			if tx != nil {
+				commit()
+			}
+		}
+	}
+	return fn
+}
+
 // GORMUpdate performs UPDATEs using GORM.
 func GORMUpdate(addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
 	fn := func(b *testing.B) {
@@ -102,3 +293,228 @@ func GORMPreparedUpdate(addresses []*types.Address, db *gorm.DB) func(b *testing
 	}
 	return fn
 }
+
+// GORMSelectContext selects records using GORM WithContext; every query has a deadline of ContextTimeout.
+func GORMSelectContext(limit int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.Address
+		var result *gorm.DB
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			result = db.WithContext(ctx).Order("pk").Limit(limit).Find(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// GORMInsertContext is GORMInsert with a deadline of ContextTimeout on every INSERT.
+func GORMInsertContext(addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var result *gorm.DB
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				result = db.WithContext(ctx).Create(address)
+				if result.Error != nil {
+					b.Fatalf("gorm failed with %v", result.Error.Error())
+				}
+				cancel()
+				// address.PostInsert(b) // TODO CreatedAt, ModifiedAt not working with our "stacked" model type.
+			}
+		}
+	}
+	return fn
+}
+
+// GORMUpdateContext is GORMUpdate with a deadline of ContextTimeout on every UPDATE.
+func GORMUpdateContext(addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var result *gorm.DB
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				result = db.WithContext(ctx).Save(address)
+				if result.Error != nil {
+					b.Fatalf("gorm failed with %v", result.Error.Error())
+				}
+				cancel()
+				// address.PostUpdate(b) // TODO CreatedAt, ModifiedAt not working with our "stacked" model type.
+			}
+		}
+	}
+	return fn
+}
+
+// GORMLookupContext is GORMLookup using WithContext with a deadline of ContextTimeout on every lookup.
+func GORMLookupContext(ids []int, prepared bool, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var result *gorm.DB
+		//
+		sess := db
+		if prepared {
+			sess = db.Session(&gorm.Session{PrepareStmt: true})
+		}
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			d := types.Address{}
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			result = sess.WithContext(ctx).First(&d, id)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			} else if d.Id != id {
+				b.Fatalf("gorm lookup of %v returned %v", id, d.Id)
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// GORMPreparedSelectContext is GORMPreparedSelect using WithContext with a deadline of ContextTimeout on every
+// query.
+func GORMPreparedSelectContext(limit int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.Address
+		var result *gorm.DB
+		//
+		sess := db.Session(&gorm.Session{PrepareStmt: true})
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			result = sess.WithContext(ctx).Order("pk").Limit(limit).Find(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// GORMSelectInContext is GORMSelectIn using WithContext with a deadline of ContextTimeout on every query.
+func GORMSelectInContext(ids []int, db *gorm.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.Address
+		var result *gorm.DB
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			result = db.WithContext(ctx).Where("pk IN ?", ids).Find(&dest)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			} else if len(dest) != len(ids) {
+				b.Fatalf("gorm selected %v of %v ids", len(dest), len(ids))
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// GORMPreparedInsertContext is GORMPreparedInsert using WithContext with a deadline of ContextTimeout on every
+// insert of the slice.
+func GORMPreparedInsertContext(addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var result *gorm.DB
+		b.StopTimer()
+		copies := make([]*types.Address, len(addresses))
+		b.StartTimer()
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			for k, v := range addresses {
+				copies[k] = &types.Address{
+					Street: v.Street,
+					City:   v.City,
+					State:  v.State,
+					Zip:    v.Zip,
+				}
+			}
+			b.StartTimer()
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			result = db.WithContext(ctx).Create(copies)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// GORMPreparedUpdateContext is GORMPreparedUpdate using WithContext with a deadline of ContextTimeout on every
+// save of the slice.
+func GORMPreparedUpdateContext(addresses []*types.Address, db *gorm.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var result *gorm.DB
+		for k := 0; k < b.N; k++ {
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			result = db.WithContext(ctx).Save(addresses)
+			if result.Error != nil {
+				b.Fatalf("gorm failed with %v", result.Error.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// GORMCancelSelector returns a ContextSelector that scans rows with GORM Raw and Scan.
+func GORMCancelSelector(db *gorm.DB) ContextSelector {
+	return func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error {
+		return db.WithContext(ctx).Raw(CancelAddressQuery(limit)).Scan(dest).Error
+	}
+}
+
+// GORMErrorOps returns the error path operations of GORM; stored is an address already in the table.  The
+// missing row uses Updates since Save inserts when no row is updated.
+func GORMErrorOps(stored *types.Address, db *gorm.DB) ErrorOps {
+	return ErrorOps{
+		ErrorDuplicateKey: func() error {
+			d := *stored
+			d.Id = 0
+			return db.Create(&d).Error
+		},
+		ErrorMissingRow: func() error {
+			d := *stored
+			d.Id = ErrorMissingPk
+			return db.Model(&d).Select("street", "city", "state", "zip").Updates(&d).Error
+		},
+		ErrorUnmappedColumn: func() error {
+			return db.Raw(ErrorUnmappedQuery()).Scan(&types.Address{}).Error
+		},
+		ErrorTypeMismatch: func() error {
+			return db.Raw(ErrorTypeMismatchQuery()).Scan(&types.Address{}).Error
+		},
+		ErrorEmptyGet: func() error {
+			return db.First(&types.Address{}, ErrorMissingPk).Error
+		},
+	}
+}
+
+// GORMErrorDeviations are the error cases where GORM deliberately deviates: Updates reports a missing row
+// through RowsAffected instead of an error, Scan discards columns it has no field for, and First fails with
+// gorm.ErrRecordNotFound, which does not wrap sql.ErrNoRows.
+var GORMErrorDeviations = ErrorDeviations{
+	ErrorMissingRow:     ErrorExpectNone,
+	ErrorUnmappedColumn: ErrorExpectNone,
+	ErrorEmptyGet:       ErrorExpectUnwrapped,
+}
+
+// GORMMismatchSelector returns the MismatchSelector of GORM.  types.Address excludes its created and
+// modified times from GORM so they are never scanned.
+func GORMMismatchSelector(db *gorm.DB) MismatchSelector {
+	return func(query string, dest *[]*types.Address) error {
+		return db.Raw(query).Scan(dest).Error
+	}
+}
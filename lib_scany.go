@@ -62,3 +62,478 @@ func ScanySelect(limit int, db *sql.DB) func(*testing.B) {
 	}
 	return fn
 }
+
+// ScanySelectSales creates a test for selecting and scanning joined sales rows with scany/sqlscan.
+func ScanySelectSales(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.SaleReport
+		ctx := context.Background()
+		//
+		query := SaleReportQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			err = sqlscan.Select(ctx, db, &dest, query)
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectWideSqlmock creates a test for selecting and scanning rows of columns columns with scany/sqlscan.
+func ScanySelectWideSqlmock(columns int, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		ctx := context.Background()
+		//
+		b.StopTimer()
+		wt := types.WideTypes[columns]
+		mockrows := wt.New().MockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = wt.NewSlice() // Reset dest
+			b.StartTimer()
+			//
+			err = sqlscan.Select(ctx, db, dest, "select * from table")
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectWide creates a test for selecting and scanning rows of columns columns with scany/sqlscan.
+func ScanySelectWide(columns int, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		ctx := context.Background()
+		//
+		wt := types.WideTypes[columns]
+		query := WideSelectQuery(columns, limit)
+		for k := 0; k < b.N; k++ {
+			dest = wt.NewSlice() // Reset dest
+			err = sqlscan.Select(ctx, db, dest, query)
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectNestedSqlmock creates a test for selecting and scanning rows into embedded structs with scany/sqlscan;
+// when pointers is true the embedded structs are pointers.
+func ScanySelectNestedSqlmock(pointers bool, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		ctx := context.Background()
+		//
+		b.StopTimer()
+		mockrows := types.NestedSaleReportMockRows(limit, ".")
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = types.NewNestedSaleReports(pointers) // Reset dest
+			b.StartTimer()
+			//
+			err = sqlscan.Select(ctx, db, dest, "select * from table")
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectNested creates a test for selecting and scanning joined sales rows into embedded structs with
+// scany/sqlscan; when pointers is true the embedded structs are pointers.
+func ScanySelectNested(pointers bool, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		ctx := context.Background()
+		//
+		query := NestedSaleReportQuery(limit, ".")
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNestedSaleReports(pointers) // Reset dest
+			err = sqlscan.Select(ctx, db, dest, query)
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectNullableSqlmock creates a test for selecting and scanning nullable rows with scany/sqlscan; ratio of
+// the values are NULL and kind is one of types.NullablePtr or types.NullableSql.
+func ScanySelectNullableSqlmock(kind string, ratio float64, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		ctx := context.Background()
+		//
+		b.StopTimer()
+		mockrows := types.NullableAddressMockRows(limit, ratio)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			b.StartTimer()
+			//
+			err = sqlscan.Select(ctx, db, dest, "select * from table")
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectNullable creates a test for selecting and scanning nullable rows with scany/sqlscan; kind is one
+// of types.NullablePtr or types.NullableSql.
+func ScanySelectNullable(kind string, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		ctx := context.Background()
+		//
+		query := NullableAddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			err = sqlscan.Select(ctx, db, dest, query)
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectKitchenSinkSqlmock creates a test for selecting and scanning rows of many column types with scany/sqlscan.
+func ScanySelectKitchenSinkSqlmock(limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.KitchenSink
+		ctx := context.Background()
+		//
+		b.StopTimer()
+		mockrows := types.KitchenSinkMockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = nil // Reset dest
+			b.StartTimer()
+			//
+			err = sqlscan.Select(ctx, db, &dest, "select * from table")
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectKitchenSink creates a test for selecting and scanning rows of many column types with scany/sqlscan.
+func ScanySelectKitchenSink(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.KitchenSink
+		ctx := context.Background()
+		//
+		query := KitchenSinkQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			err = sqlscan.Select(ctx, db, &dest, query)
+			if err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectStream creates a test for streaming rows one at a time with scany/sqlscan RowScanner into a single
+// destination; the peak heap is reported next to ns/op.
+func ScanySelectStream(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var scanner *sqlscan.RowScanner
+		var err error
+		var n int
+		d := &types.Address{}
+		//
+		query := AddressQuery(limit)
+		heap := NewHeapPeak(b)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			}
+			scanner = sqlscan.NewRowScanner(rows)
+			for n = 0; rows.Next(); n++ {
+				if err = scanner.Scan(d); err != nil {
+					b.Fatalf("scany scan failed with %v", err.Error())
+				}
+				if n%HeapSampleRows == 0 {
+					heap.Sample()
+				}
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("scany rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if n != limit {
+				b.Fatalf("scany streamed %v of %v rows", n, limit)
+			}
+		}
+		heap.Report()
+	}
+	return fn
+}
+
+// ScanyLookup creates a test for selecting one address by pk with sqlscan.ScanOne; each iteration looks up
+// the next of ids.
+func ScanyLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d types.Address
+		ctx := context.Background()
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			if rows, err = db.QueryContext(ctx, query, id); err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			} else if err = sqlscan.ScanOne(&d, rows); err != nil {
+				b.Fatalf("scany lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("scany lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// ScanyPreparedLookup creates a test for selecting one address by pk with a prepared statement and
+// sqlscan.ScanOne; each iteration looks up the next of ids.
+func ScanyPreparedLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var d types.Address
+		ctx := context.Background()
+		//
+		if stmt, err = db.PrepareContext(ctx, AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			if rows, err = stmt.QueryContext(ctx, id); err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			} else if err = sqlscan.ScanOne(&d, rows); err != nil {
+				b.Fatalf("scany lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("scany lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// ScanyPreparedSelect creates a test for scanning the rows of a prepared statement with sqlscan.ScanAll.
+func ScanyPreparedSelect(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		ctx := context.Background()
+		//
+		if stmt, err = db.PrepareContext(ctx, AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			if rows, err = stmt.QueryContext(ctx); err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			} else if err = sqlscan.ScanAll(&dest, rows); err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// ScanySelectContext creates a test for selecting and scanning rows with scany/sqlscan; every query has a
+// deadline of ContextTimeout.
+func ScanySelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.Address
+		//
+		query := AddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = sqlscan.Select(ctx, db, &dest, query); err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// ScanyLookupContext is ScanyLookup with a deadline of ContextTimeout on every lookup.
+func ScanyLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d types.Address
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = db.QueryContext(ctx, query, id); err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			} else if err = sqlscan.ScanOne(&d, rows); err != nil {
+				b.Fatalf("scany lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("scany lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// ScanyPreparedLookupContext is ScanyPreparedLookup with a deadline of ContextTimeout on the prepare and
+// every lookup.
+func ScanyPreparedLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var d types.Address
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = db.PrepareContext(ctx, AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = stmt.QueryContext(ctx, id); err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			} else if err = sqlscan.ScanOne(&d, rows); err != nil {
+				b.Fatalf("scany lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("scany lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// ScanyPreparedSelectContext is ScanyPreparedSelect with a deadline of ContextTimeout on the prepare and
+// every query.
+func ScanyPreparedSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = db.PrepareContext(ctx, AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = stmt.QueryContext(ctx); err != nil {
+				b.Fatalf("scany query failed with %v", err.Error())
+			} else if err = sqlscan.ScanAll(&dest, rows); err != nil {
+				b.Fatalf("scany select failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// ScanyCancelSelector returns a ContextSelector that scans rows with scany/sqlscan.
+func ScanyCancelSelector(db *sql.DB) ContextSelector {
+	return func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error {
+		return sqlscan.Select(ctx, db, dest, CancelAddressQuery(limit))
+	}
+}
+
+// ScanyErrorOps returns the error path operations of scany/sqlscan, which only reads.
+func ScanyErrorOps(dialect Dialect, db *sql.DB) ErrorOps {
+	ctx := context.Background()
+	return ErrorOps{
+		ErrorUnmappedColumn: func() error {
+			return sqlscan.Get(ctx, db, &types.Address{}, ErrorUnmappedQuery())
+		},
+		ErrorTypeMismatch: func() error {
+			return sqlscan.Get(ctx, db, &types.Address{}, ErrorTypeMismatchQuery())
+		},
+		ErrorEmptyGet: func() error {
+			return sqlscan.Get(ctx, db, &types.Address{}, AddressByPkQuery(dialect), ErrorMissingPk)
+		},
+	}
+}
+
+// ScanyMismatchSelector returns the MismatchSelector of scany/sqlscan.
+func ScanyMismatchSelector(db *sql.DB) MismatchSelector {
+	ctx := context.Background()
+	return func(query string, dest *[]*types.Address) error {
+		return sqlscan.Select(ctx, db, dest, query)
+	}
+}
+
+// ScanyColdSelector returns the ColdSelector of scany/sqlscan.  scany v0.2.8 has no API value to create
+// and only caches for the length of one call, so every ColdSelect uses the package functions.
+func ScanyColdSelector(db *sql.DB) ColdSelector {
+	ctx := context.Background()
+	return func() ColdSelect {
+		return func(dest interface{}, query string) error {
+			return sqlscan.Select(ctx, db, dest, query)
+		}
+	}
+}
@@ -1,11 +1,13 @@
 package sqlhbenchmarks
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/nofeaturesonlybugs/set"
 	"github.com/nofeaturesonlybugs/sqlh"
 	"github.com/nofeaturesonlybugs/sqlh/model"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
@@ -67,6 +69,27 @@ func SqlhSelect(limit int, db *sql.DB) func(*testing.B) {
 	return fn
 }
 
+// SqlhSelectSales creates a test for selecting and scanning joined sales rows with sqlh.
+func SqlhSelectSales(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.SaleReport
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := SaleReportQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			err = scanner.Select(db, &dest, query)
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
 // ModelInsert performs INSERTs using github.com/nofeaturesonlybugs/sqlh/models package.
 func ModelInsert(mdb *model.Models, addresses []*types.Address, db *sql.DB) func(b *testing.B) {
 	fn := func(b *testing.B) {
@@ -138,3 +161,638 @@ func ModelPreparedUpdate(mdb *model.Models, addresses []*types.Address, tx *sql.
 	}
 	return fn
 }
+
+// ModelTxInserter returns a TxInserter that performs an INSERT using github.com/nofeaturesonlybugs/sqlh/models
+// package.
+func ModelTxInserter(mdb *model.Models) TxInserter {
+	return func(q sqlh.IQueries, address *types.Address) error {
+		return mdb.Insert(q, address)
+	}
+}
+
+// SqlhSelectWideSqlmock creates a test for selecting and scanning rows of columns columns with sqlh.
+func SqlhSelectWideSqlmock(columns int, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		b.StopTimer()
+		wt := types.WideTypes[columns]
+		mockrows := wt.New().MockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = wt.NewSlice() // Reset dest
+			b.StartTimer()
+			//
+			err = scanner.Select(db, dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectWide creates a test for selecting and scanning rows of columns columns with sqlh.
+func SqlhSelectWide(columns int, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		wt := types.WideTypes[columns]
+		query := WideSelectQuery(columns, limit)
+		for k := 0; k < b.N; k++ {
+			dest = wt.NewSlice() // Reset dest
+			err = scanner.Select(db, dest, query)
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectNestedSqlmock creates a test for selecting and scanning rows into embedded structs with sqlh;
+// when pointers is true the embedded structs are pointers.
+func SqlhSelectNestedSqlmock(pointers bool, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		b.StopTimer()
+		mockrows := types.NestedSaleReportMockRows(limit, types.NewMapper().Join)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = types.NewNestedSaleReports(pointers) // Reset dest
+			b.StartTimer()
+			//
+			err = scanner.Select(db, dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectNested creates a test for selecting and scanning joined sales rows into embedded structs with
+// sqlh; when pointers is true the embedded structs are pointers.
+func SqlhSelectNested(pointers bool, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := NestedSaleReportQuery(limit, types.NewMapper().Join)
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNestedSaleReports(pointers) // Reset dest
+			err = scanner.Select(db, dest, query)
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectNullableSqlmock creates a test for selecting and scanning nullable rows with sqlh; ratio of
+// the values are NULL and kind is one of types.NullablePtr or types.NullableSql. sql.Null* and types.NullTime are
+// scanned through the TreatAsScalar member of types.NewMapper().
+func SqlhSelectNullableSqlmock(kind string, ratio float64, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		b.StopTimer()
+		mockrows := types.NullableAddressMockRows(limit, ratio)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			b.StartTimer()
+			//
+			err = scanner.Select(db, dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectNullable creates a test for selecting and scanning nullable rows with sqlh; kind is one
+// of types.NullablePtr or types.NullableSql.
+func SqlhSelectNullable(kind string, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := NullableAddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			err = scanner.Select(db, dest, query)
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectKitchenSinkSqlmock creates a test for selecting and scanning rows of many column types with sqlh.
+func SqlhSelectKitchenSinkSqlmock(limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.KitchenSink
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		b.StopTimer()
+		mockrows := types.KitchenSinkMockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = nil // Reset dest
+			b.StartTimer()
+			//
+			err = scanner.Select(db, &dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhSelectKitchenSink creates a test for selecting and scanning rows of many column types with sqlh.
+func SqlhSelectKitchenSink(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.KitchenSink
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := KitchenSinkQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			err = scanner.Select(db, &dest, query)
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SetBoundSelectStream creates a test for streaming rows one at a time through the set.BoundMapping behind
+// sqlh.Scanner; sqlh v0.1.0 has no row iterator so sqlh itself is never called and this only measures the
+// per-row work Scanner.ScanRows does into a single destination.  The peak heap is reported next to ns/op.
+func SetBoundSelectStream(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var columns []string
+		var assignables []interface{}
+		var err error
+		var n int
+		d := &types.Address{}
+		bound := types.NewMapper().Bind(d)
+		//
+		query := AddressQuery(limit)
+		heap := NewHeapPeak(b)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("set query failed with %v", err.Error())
+			}
+			if columns, err = rows.Columns(); err != nil {
+				b.Fatalf("set columns failed with %v", err.Error())
+			}
+			assignables = make([]interface{}, len(columns))
+			for n = 0; rows.Next(); n++ {
+				if _, err = bound.Assignables(columns, assignables); err != nil {
+					b.Fatalf("set assignables failed with %v", err.Error())
+				} else if err = rows.Scan(assignables...); err != nil {
+					b.Fatalf("set scan failed with %v", err.Error())
+				}
+				if n%HeapSampleRows == 0 {
+					heap.Sample()
+				}
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("set rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if n != limit {
+				b.Fatalf("set streamed %v of %v rows", n, limit)
+			}
+		}
+		heap.Report()
+	}
+	return fn
+}
+
+// SqlhLookup creates a test for selecting one address by pk with sqlh.Scanner.Select into a struct; each
+// iteration looks up the next of ids.
+func SqlhLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			if err = scanner.Select(db, &d, query, id); err != nil {
+				b.Fatalf("sqlh lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("sqlh lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhPreparedLookup creates a test for selecting one address by pk with sqlh.Scanner.Select into a struct
+// and a prepared statement; each iteration looks up the next of ids.
+func SqlhPreparedLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var err error
+		var d types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		if stmt, err = db.Prepare(AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		Q := preparedQueries{stmt}
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			if err = scanner.Select(Q, &d, "", id); err != nil {
+				b.Fatalf("sqlh lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("sqlh lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhPreparedSelect creates a test for scanning the rows of a prepared statement with
+// sqlh.Scanner.ScanRows.
+func SqlhPreparedSelect(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		if stmt, err = db.Prepare(AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			if rows, err = stmt.Query(); err != nil {
+				b.Fatalf("sqlh query failed with %v", err.Error())
+			} else if err = scanner.ScanRows(rows, &dest); err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// preparedQueries runs a prepared statement as sqlh.IQueries; sqlh v0.1.0 does not accept statements so
+// the query strings passed to its methods are ignored.
+type preparedQueries struct {
+	stmt *sql.Stmt
+}
+
+func (me preparedQueries) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return me.stmt.Exec(args...)
+}
+
+func (me preparedQueries) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return me.stmt.Query(args...)
+}
+
+func (me preparedQueries) QueryRow(query string, args ...interface{}) *sql.Row {
+	return me.stmt.QueryRow(args...)
+}
+
+// SqlhSelectContext creates a test for selecting and scanning rows with sqlh; every query has a deadline
+// of ContextTimeout.  sqlh v0.1.0 does not accept a context so the context is bound to the *sql.DB.
+func SqlhSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := AddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = scanner.Select(contextQueries{ctx, db}, &dest, query); err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// ModelInsertContext is ModelInsert with a deadline of ContextTimeout on every INSERT.
+func ModelInsertContext(mdb *model.Models, addresses []*types.Address, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				if err = mdb.Insert(contextQueries{ctx, db}, address); err != nil {
+					b.Fatalf("sqlh failed with %v", err.Error())
+				}
+				cancel()
+				//
+				address.PostInsert(b)
+			}
+		}
+	}
+	return fn
+}
+
+// ModelUpdateContext is ModelUpdate with a deadline of ContextTimeout on every UPDATE.
+func ModelUpdateContext(mdb *model.Models, addresses []*types.Address, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				if err = mdb.Update(contextQueries{ctx, tx}, address); err != nil {
+					b.Fatalf("sqlh failed with %v", err.Error())
+				}
+				cancel()
+				//
+				address.PostUpdate(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhLookupContext is SqlhLookup with a deadline of ContextTimeout on every lookup; the context is bound to
+// the *sql.DB.
+func SqlhLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = scanner.Select(contextQueries{ctx, db}, &d, query, id); err != nil {
+				b.Fatalf("sqlh lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("sqlh lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhPreparedLookupContext is SqlhPreparedLookup with a deadline of ContextTimeout on the prepare and every
+// lookup; the context is bound to the statement.
+func SqlhPreparedLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var err error
+		var d types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = db.PrepareContext(ctx, AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = scanner.Select(contextQueries{ctx, stmtContext{stmt}}, &d, "", id); err != nil {
+				b.Fatalf("sqlh lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("sqlh lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhPreparedSelectContext is SqlhPreparedSelect using PrepareContext and QueryContext; the prepare and
+// every query have a deadline of ContextTimeout.
+func SqlhPreparedSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = db.PrepareContext(ctx, AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = stmt.QueryContext(ctx); err != nil {
+				b.Fatalf("sqlh query failed with %v", err.Error())
+			} else if err = scanner.ScanRows(rows, &dest); err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// ModelPreparedInsertContext is ModelPreparedInsert with a deadline of ContextTimeout on every insert of the
+// slice.  The context is bound to the transaction sqlh begins (contextBeginner) so it covers the prepared
+// statement sqlh runs in it.
+func ModelPreparedInsertContext(mdb *model.Models, addresses []*types.Address, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		for k := 0; k < b.N; k++ {
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = mdb.Insert(contextBeginner{contextQueries{ctx, db}, db}, addresses); err != nil {
+				b.Fatalf("sqlh failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// SqlhCancelSelector returns a ContextSelector that scans rows with sqlh.
+func SqlhCancelSelector(db *sql.DB) ContextSelector {
+	scanner := &sqlh.Scanner{
+		Mapper: types.NewMapper(),
+	}
+	return func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error {
+		return scanner.Select(contextQueries{ctx, db}, dest, CancelAddressQuery(limit))
+	}
+}
+
+// SqlhErrorDeviations are the error cases where sqlh v0.1.0 deliberately deviates: its errors do not wrap the
+// driver error or sql.ErrNoRows, and Scanner.Select into a struct treats zero rows as success.
+var SqlhErrorDeviations = ErrorDeviations{
+	ErrorDuplicateKey: ErrorExpectUnwrapped,
+	ErrorMissingRow:   ErrorExpectUnwrapped,
+	ErrorEmptyGet:     ErrorExpectNone,
+}
+
+// SqlhErrorOps returns the error path operations of sqlh.Scanner and sqlh/model; stored is an address
+// already in the table.
+func SqlhErrorOps(mdb *model.Models, stored *types.Address, dialect Dialect, db *sql.DB) ErrorOps {
+	scanner := &sqlh.Scanner{
+		Mapper: types.NewMapper(),
+	}
+	return ErrorOps{
+		ErrorDuplicateKey: func() error {
+			d := *stored
+			return mdb.Insert(db, &d)
+		},
+		ErrorMissingRow: func() error {
+			d := *stored
+			d.Id = ErrorMissingPk
+			return mdb.Update(db, &d)
+		},
+		ErrorUnmappedColumn: func() error {
+			return scanner.Select(db, &types.Address{}, ErrorUnmappedQuery())
+		},
+		ErrorTypeMismatch: func() error {
+			return scanner.Select(db, &types.Address{}, ErrorTypeMismatchQuery())
+		},
+		ErrorEmptyGet: func() error {
+			return scanner.Select(db, &types.Address{}, AddressByPkQuery(dialect), ErrorMissingPk)
+		},
+	}
+}
+
+// SqlhMismatchSelector returns the MismatchSelector of sqlh.Scanner using mapper.
+func SqlhMismatchSelector(mapper *set.Mapper, db *sql.DB) MismatchSelector {
+	scanner := &sqlh.Scanner{
+		Mapper: mapper,
+	}
+	return func(query string, dest *[]*types.Address) error {
+		return scanner.Select(db, dest, query)
+	}
+}
+
+// SqlhSelectMapper creates a test for selecting and scanning rows with sqlh using the mapper of config;
+// when shared is false a new mapper is created for every query.
+func SqlhSelectMapper(config MapperConfig, shared bool, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		scanner := &sqlh.Scanner{
+			Mapper: config.Mapper(),
+		}
+		//
+		query := config.Query(limit)
+		for k := 0; k < b.N; k++ {
+			dest = config.Dest() // Reset dest
+			if !shared {
+				scanner = &sqlh.Scanner{
+					Mapper: config.Mapper(),
+				}
+			}
+			err = scanner.Select(db, dest, query)
+			if err != nil {
+				b.Fatalf("sqlh select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlhColdSelector returns the ColdSelector of sqlh; each ColdSelect has a new sqlh.Scanner and
+// types.NewMapper() and replaces the global set.TypeCache with an empty one.  set has no per-mapper cache so
+// the original set.TypeCache is restored when b finishes.
+func SqlhColdSelector(b *testing.B, db *sql.DB) ColdSelector {
+	original := set.TypeCache
+	b.Cleanup(func() {
+		set.TypeCache = original
+	})
+	return func() ColdSelect {
+		set.TypeCache = set.NewTypeInfoCache()
+		scanner := &sqlh.Scanner{
+			Mapper: types.NewMapper(),
+		}
+		return func(dest interface{}, query string) error {
+			return scanner.Select(db, dest, query)
+		}
+	}
+}
@@ -1,12 +1,15 @@
 package sqlhbenchmarks
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/reflectx"
+	"github.com/nofeaturesonlybugs/sqlh"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
 
@@ -61,3 +64,855 @@ func SqlxSelect(limit int, db *sql.DB) func(*testing.B) {
 	}
 	return fn
 }
+
+// SqlxSelectSales creates a test for selecting and scanning joined sales rows with sqlx.
+func SqlxSelectSales(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.SaleReport
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := SaleReportQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			err = dbx.Select(&dest, query)
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectWideSqlmock creates a test for selecting and scanning rows of columns columns with sqlx.
+func SqlxSelectWideSqlmock(columns int, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		b.StopTimer()
+		wt := types.WideTypes[columns]
+		mockrows := wt.New().MockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = wt.NewSlice() // Reset dest
+			b.StartTimer()
+			//
+			err = dbx.Select(dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectWide creates a test for selecting and scanning rows of columns columns with sqlx.
+func SqlxSelectWide(columns int, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		wt := types.WideTypes[columns]
+		query := WideSelectQuery(columns, limit)
+		for k := 0; k < b.N; k++ {
+			dest = wt.NewSlice() // Reset dest
+			err = dbx.Select(dest, query)
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectNestedSqlmock creates a test for selecting and scanning rows into embedded structs with sqlx;
+// when pointers is true the embedded structs are pointers.
+func SqlxSelectNestedSqlmock(pointers bool, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		b.StopTimer()
+		mockrows := types.NestedSaleReportMockRows(limit, ".")
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = types.NewNestedSaleReports(pointers) // Reset dest
+			b.StartTimer()
+			//
+			err = dbx.Select(dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectNested creates a test for selecting and scanning joined sales rows into embedded structs with
+// sqlx; when pointers is true the embedded structs are pointers.
+func SqlxSelectNested(pointers bool, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := NestedSaleReportQuery(limit, ".")
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNestedSaleReports(pointers) // Reset dest
+			err = dbx.Select(dest, query)
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectNullableSqlmock creates a test for selecting and scanning nullable rows with sqlx; ratio of
+// the values are NULL and kind is one of types.NullablePtr or types.NullableSql.
+func SqlxSelectNullableSqlmock(kind string, ratio float64, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		b.StopTimer()
+		mockrows := types.NullableAddressMockRows(limit, ratio)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			b.StartTimer()
+			//
+			err = dbx.Select(dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectNullable creates a test for selecting and scanning nullable rows with sqlx; kind is one
+// of types.NullablePtr or types.NullableSql.
+func SqlxSelectNullable(kind string, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest interface{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := NullableAddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = types.NewNullableAddresses(kind) // Reset dest
+			err = dbx.Select(dest, query)
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectKitchenSinkSqlmock creates a test for selecting and scanning rows of many column types with sqlx.
+func SqlxSelectKitchenSinkSqlmock(limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.KitchenSink
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		b.StopTimer()
+		mockrows := types.KitchenSinkMockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			dest = nil // Reset dest
+			b.StartTimer()
+			//
+			err = dbx.Select(&dest, "select * from table")
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectKitchenSink creates a test for selecting and scanning rows of many column types with sqlx.
+func SqlxSelectKitchenSink(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.KitchenSink
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := KitchenSinkQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			err = dbx.Select(&dest, query)
+			if err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectStream creates a test for streaming rows one at a time with sqlx Rows.StructScan into a single
+// destination; the peak heap is reported next to ns/op.
+func SqlxSelectStream(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sqlx.Rows
+		var err error
+		var n int
+		d := &types.Address{}
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := AddressQuery(limit)
+		heap := NewHeapPeak(b)
+		for k := 0; k < b.N; k++ {
+			rows, err = dbx.Queryx(query)
+			if err != nil {
+				b.Fatalf("sqlx query failed with %v", err.Error())
+			}
+			for n = 0; rows.Next(); n++ {
+				if err = rows.StructScan(d); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				if n%HeapSampleRows == 0 {
+					heap.Sample()
+				}
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("sqlx rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if n != limit {
+				b.Fatalf("sqlx streamed %v of %v rows", n, limit)
+			}
+		}
+		heap.Report()
+	}
+	return fn
+}
+
+// SqlxLookup creates a test for selecting one address by pk with sqlx.Get; each iteration looks up the next
+// of ids.
+func SqlxLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			if err = dbx.Get(&d, query, id); err != nil {
+				b.Fatalf("sqlx lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("sqlx lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxPreparedLookup creates a test for selecting one address by pk with sqlx.Stmt.Get; each iteration
+// looks up the next of ids.
+func SqlxPreparedLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sqlx.Stmt
+		var err error
+		var d types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		if stmt, err = dbx.Preparex(AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			if err = stmt.Get(&d, id); err != nil {
+				b.Fatalf("sqlx lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("sqlx lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxPreparedSelect creates a test for selecting and scanning rows from a statement prepared with
+// sqlx.Preparex.
+func SqlxPreparedSelect(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sqlx.Stmt
+		var err error
+		var dest []*types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		if stmt, err = dbx.Preparex(AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			if err = stmt.Select(&dest); err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectIn creates a test for selecting the addresses with pk in ids with sqlx.In and Rebind on every
+// iteration.
+func SqlxSelectIn(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.Address
+		var query string
+		var args []interface{}
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			if query, args, err = sqlx.In(AddressInQuery("?"), ids); err != nil {
+				b.Fatalf("sqlx in failed with %v", err.Error())
+			}
+			if err = dbx.Select(&dest, dbx.Rebind(query), args...); err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+			if len(dest) != len(ids) {
+				b.Fatalf("sqlx selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// sqlxInsertQuery is the named-parameter INSERT used by the sqlx insert benchmarks.
+const sqlxInsertQuery = `
+	insert into %v ( street, city, state, zip )
+	values ( :street, :city, :state, :zip )
+	returning pk, created_tmz, modified_tmz
+`
+
+// sqlxBulkInsertQuery is sqlxInsertQuery returning the natural key the batch insert matches rows on.
+const sqlxBulkInsertQuery = `
+	insert into %v ( street, city, state, zip )
+	values ( :street, :city, :state, :zip )
+	returning pk, created_tmz, modified_tmz, street, city, state, zip
+`
+
+// sqlxUpdateQuery is the named-parameter UPDATE used by the sqlx update benchmarks.
+const sqlxUpdateQuery = `
+	update %v set
+		street = :street, city = :city, state = :state, zip = :zip
+	where pk = :pk
+	returning modified_tmz
+`
+
+// SqlxInsert performs INSERTs using NamedQuery() -> rows.StructScan() over the range of models using sqlx.
+func SqlxInsert(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxInsertQuery, types.AddressTableName)
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		var rows *sqlx.Rows
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+				//
+				if rows, err = dbx.NamedQuery(query, address); err != nil {
+					b.Fatalf("sqlx failed with %v", err.Error())
+				}
+				if !rows.Next() {
+					b.Fatalf("sqlx insert returned no rows")
+				} else if err = rows.StructScan(address); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				rows.Close()
+				//
+				address.PostInsert(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxPreparedInsert performs INSERTs using PrepareNamed() -> QueryRowx() -> row.StructScan() over the
+// range of models using sqlx.
+func SqlxPreparedInsert(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxInsertQuery, types.AddressTableName)
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		var tx *sqlx.Tx
+		var stmt *sqlx.NamedStmt
+		var err error
+		//
+		if tx, err = dbx.Beginx(); err != nil {
+			b.Fatalf("error beginning transaction with %v", err.Error())
+		}
+		defer tx.Rollback()
+		if stmt, err = tx.PrepareNamed(query); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				if err = stmt.QueryRowx(address).StructScan(address); err != nil {
+					b.Fatalf("sqlx failed with %v", err.Error())
+				}
+			}
+		}
+		//
+		if err = tx.Commit(); err != nil {
+			b.Fatalf("error durring commit with %v", err.Error())
+		}
+	}
+	return fn
+}
+
+// SqlxBulkInsert performs one batch INSERT per iteration by passing the slice of models to NamedQuery; the
+// returned rows are scanned with StructScan and matched to the models by natural key (bulkInserted).
+func SqlxBulkInsert(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxBulkInsertQuery, types.AddressTableName)
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		var rows *sqlx.Rows
+		var err error
+		var n int
+		var returned types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+			}
+			if rows, err = dbx.NamedQuery(query, addresses); err != nil {
+				b.Fatalf("sqlx failed with %v", err.Error())
+			}
+			inserted := newBulkInserted(addresses)
+			for n = 0; rows.Next(); n++ {
+				if err = rows.StructScan(&returned); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				inserted.take(b, "sqlx", &returned)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("sqlx rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if n != len(addresses) {
+				b.Fatalf("sqlx returned %v of %v rows", n, len(addresses))
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxTxInserter returns a TxInserter that performs a named-parameter INSERT with sqlx.  sqlx can not wrap an
+// existing *sql.Tx so the query is bound with BindNamed and the returned row is scanned by a sqlx.Rows with
+// the default mapper.
+func SqlxTxInserter(dialect Dialect) TxInserter {
+	query := fmt.Sprintf(sqlxInsertQuery, types.AddressTableName)
+	bindType := sqlx.BindType(string(dialect))
+	mapper := reflectx.NewMapperFunc("db", sqlx.NameMapper)
+	return func(q sqlh.IQueries, address *types.Address) error {
+		bound, args, err := sqlx.BindNamed(bindType, query, address)
+		if err != nil {
+			return err
+		}
+		rows, err := q.Query(bound, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		rowsx := &sqlx.Rows{Rows: rows, Mapper: mapper}
+		if !rowsx.Next() {
+			if err = rowsx.Err(); err != nil {
+				return err
+			}
+			return sql.ErrNoRows
+		}
+		return rowsx.StructScan(address)
+	}
+}
+
+// SqlxUpdate performs UPDATEs using NamedQuery() -> rows.StructScan() over the range of models using sqlx.
+func SqlxUpdate(addresses []*types.Address, tx *sqlx.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxUpdateQuery, types.AddressTableName)
+		//
+		var rows *sqlx.Rows
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				if rows, err = tx.NamedQuery(query, address); err != nil {
+					b.Fatalf("sqlx failed with %v", err.Error())
+				}
+				if !rows.Next() {
+					b.Fatalf("sqlx update returned no rows")
+				} else if err = rows.StructScan(address); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				rows.Close()
+				//
+				address.PostUpdate(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxPreparedUpdate performs UPDATEs using PrepareNamed() -> QueryRowx() -> row.StructScan() over the
+// range of models using sqlx.
+func SqlxPreparedUpdate(addresses []*types.Address, tx *sqlx.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxUpdateQuery, types.AddressTableName)
+		//
+		var stmt *sqlx.NamedStmt
+		var err error
+		//
+		if stmt, err = tx.PrepareNamed(query); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				if err = stmt.QueryRowx(address).StructScan(address); err != nil {
+					b.Fatalf("sqlx failed with %v", err.Error())
+				}
+				//
+				address.PostUpdate(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxSelectContext creates a test for selecting and scanning rows with sqlx SelectContext; every query
+// has a deadline of ContextTimeout.
+func SqlxSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := AddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = dbx.SelectContext(ctx, &dest, query); err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// SqlxInsertContext is SqlxInsert using NamedQueryContext with a deadline of ContextTimeout on every INSERT.
+func SqlxInsertContext(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxInsertQuery, types.AddressTableName)
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		var rows *sqlx.Rows
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				if rows, err = dbx.NamedQueryContext(ctx, query, address); err != nil {
+					b.Fatalf("sqlx failed with %v", err.Error())
+				}
+				if !rows.Next() {
+					b.Fatalf("sqlx insert returned no rows")
+				} else if err = rows.StructScan(address); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				rows.Close()
+				cancel()
+				//
+				address.PostInsert(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxUpdateContext is SqlxUpdate using sqlx.NamedQueryContext with a deadline of ContextTimeout on every
+// UPDATE.
+func SqlxUpdateContext(addresses []*types.Address, tx *sqlx.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxUpdateQuery, types.AddressTableName)
+		//
+		var rows *sqlx.Rows
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				if rows, err = sqlx.NamedQueryContext(ctx, tx, query, address); err != nil {
+					b.Fatalf("sqlx failed with %v", err.Error())
+				}
+				if !rows.Next() {
+					b.Fatalf("sqlx update returned no rows")
+				} else if err = rows.StructScan(address); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				rows.Close()
+				cancel()
+				//
+				address.PostUpdate(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxLookupContext is SqlxLookup using GetContext with a deadline of ContextTimeout on every lookup.
+func SqlxLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = dbx.GetContext(ctx, &d, query, id); err != nil {
+				b.Fatalf("sqlx lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("sqlx lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxPreparedLookupContext is SqlxPreparedLookup using PreparexContext and Stmt.GetContext; the prepare and
+// every lookup have a deadline of ContextTimeout.
+func SqlxPreparedLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sqlx.Stmt
+		var err error
+		var d types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = dbx.PreparexContext(ctx, AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = stmt.GetContext(ctx, &d, id); err != nil {
+				b.Fatalf("sqlx lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("sqlx lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxPreparedSelectContext is SqlxPreparedSelect using PreparexContext and Stmt.SelectContext; the prepare
+// and every query have a deadline of ContextTimeout.
+func SqlxPreparedSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sqlx.Stmt
+		var err error
+		var dest []*types.Address
+		dbx := sqlx.NewDb(db, "postgres")
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = dbx.PreparexContext(ctx, AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = stmt.SelectContext(ctx, &dest); err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// SqlxSelectInContext is SqlxSelectIn using SelectContext with a deadline of ContextTimeout on every query.
+func SqlxSelectInContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var dest []*types.Address
+		var query string
+		var args []interface{}
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			if query, args, err = sqlx.In(AddressInQuery("?"), ids); err != nil {
+				b.Fatalf("sqlx in failed with %v", err.Error())
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if err = dbx.SelectContext(ctx, &dest, dbx.Rebind(query), args...); err != nil {
+				b.Fatalf("sqlx select failed with %v", err.Error())
+			}
+			cancel()
+			if len(dest) != len(ids) {
+				b.Fatalf("sqlx selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxBulkInsertContext is SqlxBulkInsert using NamedQueryContext with a deadline of ContextTimeout on every
+// INSERT.
+func SqlxBulkInsertContext(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := fmt.Sprintf(sqlxBulkInsertQuery, types.AddressTableName)
+		dbx := sqlx.NewDb(db, string(dialect))
+		//
+		var rows *sqlx.Rows
+		var err error
+		var n int
+		var returned types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = dbx.NamedQueryContext(ctx, query, addresses); err != nil {
+				b.Fatalf("sqlx failed with %v", err.Error())
+			}
+			inserted := newBulkInserted(addresses)
+			for n = 0; rows.Next(); n++ {
+				if err = rows.StructScan(&returned); err != nil {
+					b.Fatalf("sqlx scan failed with %v", err.Error())
+				}
+				inserted.take(b, "sqlx", &returned)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("sqlx rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+			if n != len(addresses) {
+				b.Fatalf("sqlx returned %v of %v rows", n, len(addresses))
+			}
+		}
+	}
+	return fn
+}
+
+// SqlxCancelSelector returns a ContextSelector that scans rows with sqlx SelectContext.
+func SqlxCancelSelector(db *sql.DB) ContextSelector {
+	dbx := sqlx.NewDb(db, "postgres")
+	return func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error {
+		return dbx.SelectContext(ctx, dest, CancelAddressQuery(limit))
+	}
+}
+
+// SqlxErrorOps returns the error path operations of sqlx; stored is an address already in the table.  The
+// writes use NamedStmt.QueryRowx and the reads use Get; the statements are closed with db.
+func SqlxErrorOps(stored *types.Address, dialect Dialect, db *sql.DB) (ErrorOps, error) {
+	var insert, update *sqlx.NamedStmt
+	var err error
+	dbx := sqlx.NewDb(db, string(dialect))
+	if insert, err = dbx.PrepareNamed(fmt.Sprintf(sqlxInsertQuery, types.AddressTableName)); err != nil {
+		return nil, err
+	} else if update, err = dbx.PrepareNamed(fmt.Sprintf(sqlxUpdateQuery, types.AddressTableName)); err != nil {
+		insert.Close()
+		return nil, err
+	}
+	return ErrorOps{
+		ErrorDuplicateKey: func() error {
+			d := *stored
+			return insert.QueryRowx(&d).StructScan(&d)
+		},
+		ErrorMissingRow: func() error {
+			d := *stored
+			d.Id = ErrorMissingPk
+			return update.QueryRowx(&d).StructScan(&d)
+		},
+		ErrorUnmappedColumn: func() error {
+			return dbx.Get(&types.Address{}, ErrorUnmappedQuery())
+		},
+		ErrorTypeMismatch: func() error {
+			return dbx.Get(&types.Address{}, ErrorTypeMismatchQuery())
+		},
+		ErrorEmptyGet: func() error {
+			return dbx.Get(&types.Address{}, AddressByPkQuery(dialect), ErrorMissingPk)
+		},
+	}, nil
+}
+
+// SqlxMismatchSelector returns the MismatchSelector of sqlx; when unsafe is true the selects run on
+// DB.Unsafe(), which ignores columns without a destination.
+func SqlxMismatchSelector(unsafe bool, dialect Dialect, db *sql.DB) MismatchSelector {
+	dbx := sqlx.NewDb(db, string(dialect))
+	if unsafe {
+		dbx = dbx.Unsafe()
+	}
+	return func(query string, dest *[]*types.Address) error {
+		return dbx.Select(dest, query)
+	}
+}
+
+// SqlxColdSelector returns the ColdSelector of sqlx; each ColdSelect has a new sqlx.DB and therefore a new
+// reflectx.Mapper.
+func SqlxColdSelector(dialect Dialect, db *sql.DB) ColdSelector {
+	return func() ColdSelect {
+		dbx := sqlx.NewDb(db, string(dialect))
+		return func(dest interface{}, query string) error {
+			return dbx.Select(dest, query)
+		}
+	}
+}
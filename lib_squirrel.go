@@ -1,10 +1,12 @@
 package sqlhbenchmarks
 
 import (
+	"context"
 	"database/sql"
 	"testing"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/nofeaturesonlybugs/sqlh"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
 
@@ -70,6 +72,61 @@ func SquirrelPreparedInsert(addresses []*types.Address, db *sql.DB) func(b *test
 	return fn
 }
 
+// SquirrelBulkInsert performs one multi-row INSERT per iteration by calling Values once per model with
+// github.com/Masterminds/squirrel; the returned rows are matched to the models by natural key (bulkInserted).
+func SquirrelBulkInsert(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var n int
+		var returned types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			query := sq.Insert(types.AddressTableName).
+				Columns("street", "city", "state", "zip")
+			for _, address := range addresses {
+				address.PreInsert(b)
+				query = query.Values(address.Street, address.City, address.State, address.Zip)
+			}
+			query = query.Suffix("RETURNING pk, created_tmz, modified_tmz, street, city, state, zip").
+				RunWith(db).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			if rows, err = query.Query(); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			inserted := newBulkInserted(addresses)
+			for n = 0; rows.Next(); n++ {
+				if err = rows.Scan(&returned.Id, &returned.CreatedTime, &returned.ModifiedTime,
+					&returned.Street, &returned.City, &returned.State, &returned.Zip); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				inserted.take(b, "squirrel", &returned)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if n != len(addresses) {
+				b.Fatalf("squirrel returned %v of %v rows", n, len(addresses))
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelTxInserter returns a TxInserter that performs an INSERT using github.com/Masterminds/squirrel.
+func SquirrelTxInserter(dialect Dialect) TxInserter {
+	return func(q sqlh.IQueries, address *types.Address) error {
+		query := sq.Insert(types.AddressTableName).
+			Columns("street", "city", "state", "zip").
+			Values(address.Street, address.City, address.State, address.Zip).
+			Suffix("RETURNING pk, created_tmz, modified_tmz").
+			RunWith(q).
+			PlaceholderFormat(squirrelPlaceholders(dialect))
+		return query.QueryRow().Scan(&address.Id, &address.CreatedTime, &address.ModifiedTime)
+	}
+}
+
 // SquirrelUpdate performs UPDATEs using github.com/Masterminds/squirrel.
 func SquirrelUpdate(addresses []*types.Address, tx *sql.Tx) func(b *testing.B) {
 	fn := func(b *testing.B) {
@@ -130,3 +187,473 @@ func SquirrelPreparedUpdate(addresses []*types.Address, tx *sql.Tx) func(b *test
 	}
 	return fn
 }
+
+// squirrelPlaceholders returns the squirrel placeholder format for dialect.
+func squirrelPlaceholders(dialect Dialect) sq.PlaceholderFormat {
+	if dialect == Postgres {
+		return sq.Dollar
+	}
+	return sq.Question
+}
+
+// SquirrelLookup selects one address by pk using github.com/Masterminds/squirrel; each iteration looks up
+// the next of ids.
+func SquirrelLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	return squirrelLookup(ids, dialect, db)
+}
+
+// SquirrelPreparedLookup selects one address by pk using github.com/Masterminds/squirrel with a statement
+// cache; each iteration looks up the next of ids.
+func SquirrelPreparedLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		dbcache := sq.NewStmtCache(db)
+		defer dbcache.Clear()
+		squirrelLookup(ids, dialect, dbcache)(b)
+	}
+	return fn
+}
+
+// squirrelLookup is the body of SquirrelLookup and SquirrelPreparedLookup.
+func squirrelLookup(ids []int, dialect Dialect, runner sq.BaseRunner) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				Where(sq.Eq{"pk": id}).
+				RunWith(runner).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			err = query.QueryRow().Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+			if err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("squirrel lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelPreparedSelect selects records using github.com/Masterminds/squirrel with a statement cache.
+func SquirrelPreparedSelect(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		dbcache := sq.NewStmtCache(db)
+		defer dbcache.Clear()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				OrderBy("pk").
+				Limit(uint64(limit)).
+				RunWith(dbcache)
+			if rows, err = query.Query(); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// SquirrelSelectIn selects the addresses with pk in ids using github.com/Masterminds/squirrel with sq.Eq and
+// a slice.
+func SquirrelSelectIn(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				Where(sq.Eq{"pk": ids}).
+				RunWith(db).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			if rows, err = query.Query(); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if len(dest) != len(ids) {
+				b.Fatalf("squirrel selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelSelectContext creates a test for selecting and scanning rows with github.com/Masterminds/squirrel
+// QueryContext; every query has a deadline of ContextTimeout.
+func SquirrelSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				OrderBy("pk").
+				Limit(uint64(limit)).
+				RunWith(db)
+			if rows, err = query.QueryContext(ctx); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+		}
+	}
+	return fn
+}
+
+// SquirrelInsertContext is SquirrelInsert using QueryRowContext with a deadline of ContextTimeout on every
+// INSERT.
+func SquirrelInsertContext(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				query := sq.Insert(types.AddressTableName).
+					Columns("street", "city", "state", "zip").
+					Values(address.Street, address.City, address.State, address.Zip).
+					Suffix("RETURNING pk, created_tmz, modified_tmz").
+					RunWith(db).
+					PlaceholderFormat(squirrelPlaceholders(dialect))
+				if err = query.QueryRowContext(ctx).Scan(&address.Id, &address.CreatedTime, &address.ModifiedTime); err != nil {
+					b.Fatalf("squirrel failed with %v", err.Error())
+				}
+				cancel()
+				//
+				address.PostInsert(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelUpdateContext is SquirrelUpdate using QueryRowContext with a deadline of ContextTimeout on every
+// UPDATE.
+func SquirrelUpdateContext(addresses []*types.Address, dialect Dialect, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				query := sq.Update(types.AddressTableName).
+					Set("street", address.Street).
+					Set("city", address.City).
+					Set("state", address.State).
+					Set("zip", address.Zip).
+					Where(sq.Eq{"pk": address.Id}).
+					Suffix("RETURNING modified_tmz").
+					RunWith(tx).
+					PlaceholderFormat(squirrelPlaceholders(dialect))
+				if err = query.QueryRowContext(ctx).Scan(&address.ModifiedTime); err != nil {
+					b.Fatalf("squirrel failed with %v", err.Error())
+				}
+				cancel()
+				//
+				address.PostUpdate(b)
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelLookupContext is SquirrelLookup using QueryRowContext with a deadline of ContextTimeout on every
+// lookup.
+func SquirrelLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	return squirrelLookupContext(ids, dialect, db)
+}
+
+// SquirrelPreparedLookupContext is SquirrelPreparedLookup using QueryRowContext with a deadline of
+// ContextTimeout on every lookup; the statement cache prepares with the context of the first lookup.
+func SquirrelPreparedLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		dbcache := sq.NewStmtCache(db)
+		defer dbcache.Clear()
+		squirrelLookupContext(ids, dialect, dbcache)(b)
+	}
+	return fn
+}
+
+// squirrelLookupContext is the body of SquirrelLookupContext and SquirrelPreparedLookupContext.
+func squirrelLookupContext(ids []int, dialect Dialect, runner sq.BaseRunner) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				Where(sq.Eq{"pk": id}).
+				RunWith(runner).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			err = query.QueryRowContext(ctx).Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+			if err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("squirrel lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelPreparedSelectContext is SquirrelPreparedSelect using QueryContext with a deadline of
+// ContextTimeout on every query; the statement cache prepares with the context of the first query.
+func SquirrelPreparedSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		dbcache := sq.NewStmtCache(db)
+		defer dbcache.Clear()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				OrderBy("pk").
+				Limit(uint64(limit)).
+				RunWith(dbcache)
+			if rows, err = query.QueryContext(ctx); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+		}
+	}
+	return fn
+}
+
+// SquirrelSelectInContext is SquirrelSelectIn using QueryContext with a deadline of ContextTimeout on every
+// query.
+func SquirrelSelectInContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				Where(sq.Eq{"pk": ids}).
+				RunWith(db).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			if rows, err = query.QueryContext(ctx); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+			if len(dest) != len(ids) {
+				b.Fatalf("squirrel selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelBulkInsertContext is SquirrelBulkInsert using QueryContext with a deadline of ContextTimeout on
+// every INSERT.
+func SquirrelBulkInsertContext(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var n int
+		var returned types.Address
+		//
+		for k := 0; k < b.N; k++ {
+			query := sq.Insert(types.AddressTableName).
+				Columns("street", "city", "state", "zip")
+			for _, address := range addresses {
+				address.PreInsert(b)
+				query = query.Values(address.Street, address.City, address.State, address.Zip)
+			}
+			query = query.Suffix("RETURNING pk, created_tmz, modified_tmz, street, city, state, zip").
+				RunWith(db).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = query.QueryContext(ctx); err != nil {
+				b.Fatalf("squirrel failed with %v", err.Error())
+			}
+			inserted := newBulkInserted(addresses)
+			for n = 0; rows.Next(); n++ {
+				if err = rows.Scan(&returned.Id, &returned.CreatedTime, &returned.ModifiedTime,
+					&returned.Street, &returned.City, &returned.State, &returned.Zip); err != nil {
+					b.Fatalf("squirrel scan failed with %v", err.Error())
+				}
+				inserted.take(b, "squirrel", &returned)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("squirrel rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+			if n != len(addresses) {
+				b.Fatalf("squirrel returned %v of %v rows", n, len(addresses))
+			}
+		}
+	}
+	return fn
+}
+
+// SquirrelCancelSelector returns a ContextSelector that scans rows with github.com/Masterminds/squirrel.
+func SquirrelCancelSelector(db *sql.DB) ContextSelector {
+	return func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error {
+		query := sq.Select(types.CancelAddressColumns[0 : len(types.CancelAddressColumns)-1]...).
+			Column("0 as cancel_trigger").
+			From(types.AddressTableName).
+			OrderBy("pk").
+			Limit(uint64(limit)).
+			RunWith(db)
+		rows, err := query.QueryContext(ctx)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			d := &types.CancelAddress{}
+			if err = rows.Scan(d.Pointers()...); err != nil {
+				return err
+			}
+			*dest = append(*dest, d)
+		}
+		return rows.Err()
+	}
+}
+
+// SquirrelErrorOps returns the error path operations of github.com/Masterminds/squirrel; stored is an
+// address already in the table.  squirrel leaves scanning to database/sql so the scan cases are omitted.
+func SquirrelErrorOps(stored *types.Address, dialect Dialect, db *sql.DB) ErrorOps {
+	insert := SquirrelTxInserter(dialect)
+	return ErrorOps{
+		ErrorDuplicateKey: func() error {
+			d := *stored
+			return insert(db, &d)
+		},
+		ErrorMissingRow: func() error {
+			d := *stored
+			query := sq.Update(types.AddressTableName).
+				Set("street", d.Street).
+				Set("city", d.City).
+				Set("state", d.State).
+				Set("zip", d.Zip).
+				Where(sq.Eq{"pk": ErrorMissingPk}).
+				Suffix("RETURNING modified_tmz").
+				RunWith(db).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			return query.QueryRow().Scan(&d.ModifiedTime)
+		},
+		ErrorEmptyGet: func() error {
+			d := &types.Address{}
+			query := sq.Select("pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip").
+				From(types.AddressTableName).
+				Where(sq.Eq{"pk": ErrorMissingPk}).
+				RunWith(db).
+				PlaceholderFormat(squirrelPlaceholders(dialect))
+			return query.QueryRow().Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+		},
+	}
+}
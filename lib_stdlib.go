@@ -1,11 +1,16 @@
 package sqlhbenchmarks
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/nofeaturesonlybugs/sqlh"
 	"github.com/nofeaturesonlybugs/sqlh/grammar"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
@@ -91,6 +96,41 @@ func StandardSelect(limit int, db *sql.DB) func(*testing.B) {
 	return fn
 }
 
+// StandardSelectSales creates a test for selecting and scanning joined sales rows with database/sql.
+func StandardSelectSales(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d *types.SaleReport
+		//
+		query := SaleReportQuery(limit)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.SaleReport{}
+				err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Price, &d.Quantity, &d.Total,
+					&d.CustomerId, &d.CustomerFirst, &d.CustomerLast,
+					&d.VendorId, &d.VendorName, &d.VendorDescription,
+					&d.VendorContactId, &d.VendorContactFirst, &d.VendorContactLast,
+				)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
 // StandardInsert performs INSERTs using QueryRow() -> row.Scan() over the range of models using
 // standard database/sql package.
 func StandardInsert(addresses []*types.Address, g *grammar.Grammar, db *sql.DB) func(b *testing.B) {
@@ -184,6 +224,131 @@ func StandardPreparedInsert(addresses []*types.Address, g *grammar.Grammar, db *
 	return fn
 }
 
+// StandardBulkInsert performs one multi-row INSERT ... VALUES per iteration with the standard database/sql
+// package; the returned rows are matched to the models by natural key (bulkInserted).
+func StandardBulkInsert(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			query, args := standardBulkInsertQuery(b, addresses, dialect)
+			if rows, err = db.Query(query, args...); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkInserted(b, rows, addresses)
+		}
+	}
+	return fn
+}
+
+// standardBulkInsertQuery calls PreInsert on addresses and returns the multi-row INSERT of StandardBulkInsert
+// and its arguments.
+func standardBulkInsertQuery(b *testing.B, addresses []*types.Address, dialect Dialect) (string, []interface{}) {
+	var query strings.Builder
+	args := make([]interface{}, 0, 4*len(addresses))
+	query.WriteString("insert into " + types.AddressTableName + " ( street, city, state, zip ) values ")
+	for j, address := range addresses {
+		address.PreInsert(b)
+		if j > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("( ")
+		for c := 0; c < 4; c++ {
+			if c > 0 {
+				query.WriteString(", ")
+			}
+			if dialect == Postgres {
+				query.WriteString("$" + strconv.Itoa(len(args)+c+1))
+			} else {
+				query.WriteString("?")
+			}
+		}
+		query.WriteString(" )")
+		args = append(args, address.Street, address.City, address.State, address.Zip)
+	}
+	query.WriteString(" returning pk, created_tmz, modified_tmz, street, city, state, zip")
+	return query.String(), args
+}
+
+// standardBulkInserted scans the rows returned by standardBulkInsertQuery into the matching models of
+// addresses and closes rows.
+func standardBulkInserted(b *testing.B, rows *sql.Rows, addresses []*types.Address) {
+	var n int
+	var returned types.Address
+	var err error
+	inserted := newBulkInserted(addresses)
+	for n = 0; rows.Next(); n++ {
+		if err = rows.Scan(&returned.Id, &returned.CreatedTime, &returned.ModifiedTime,
+			&returned.Street, &returned.City, &returned.State, &returned.Zip); err != nil {
+			b.Fatalf("standard scan failed with %v", err.Error())
+		}
+		inserted.take(b, "standard", &returned)
+	}
+	if err = rows.Err(); err != nil {
+		b.Fatalf("standard rows.Err failed with %v", err.Error())
+	}
+	rows.Close()
+	if n != len(addresses) {
+		b.Fatalf("standard returned %v of %v rows", n, len(addresses))
+	}
+}
+
+// StandardCopyInsert performs INSERTs with Postgres COPY via pq.CopyIn in a transaction.  COPY does not
+// return rows so the models are not updated with their ids.
+func StandardCopyInsert(addresses []*types.Address, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var tx *sql.Tx
+		var stmt *sql.Stmt
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			if tx, err = db.Begin(); err != nil {
+				b.Fatalf("error beginning transaction with %v", err.Error())
+			}
+			if stmt, err = tx.Prepare(pq.CopyIn(types.AddressTableName, "street", "city", "state", "zip")); err != nil {
+				b.Fatalf("error preparing copy with %v", err.Error())
+			}
+			for _, address := range addresses {
+				if _, err = stmt.Exec(address.Street, address.City, address.State, address.Zip); err != nil {
+					b.Fatalf("copy failed with %v", err.Error())
+				}
+			}
+			if _, err = stmt.Exec(); err != nil {
+				b.Fatalf("copy flush failed with %v", err.Error())
+			}
+			if err = stmt.Close(); err != nil {
+				b.Fatalf("copy close failed with %v", err.Error())
+			}
+			if err = tx.Commit(); err != nil {
+				b.Fatalf("error durring commit with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// StandardTxInserter returns a TxInserter that performs an INSERT using QueryRow() -> row.Scan() with the
+// standard database/sql package.
+func StandardTxInserter(dialect Dialect) TxInserter {
+	query := `
+		insert into %v ( street, city, state, zip )
+		values ( ?, ?, ?, ? )
+		returning pk, created_tmz, modified_tmz
+	`
+	if dialect == Postgres {
+		query = `
+			insert into %v ( street, city, state, zip )
+			values ( $1, $2, $3, $4 )
+			returning pk, created_tmz, modified_tmz
+		`
+	}
+	query = fmt.Sprintf(query, types.AddressTableName)
+	return func(q sqlh.IQueries, address *types.Address) error {
+		return q.QueryRow(query, address.Street, address.City, address.State, address.Zip).Scan(&address.Id, &address.CreatedTime, &address.ModifiedTime)
+	}
+}
+
 // StandardUpdate performs UPDATEs using QueryRow() -> row.Scan() over the range of models using
 // standard database/sql package.
 func StandardUpdate(addresses []*types.Address, g *grammar.Grammar, tx *sql.Tx) func(b *testing.B) {
@@ -274,3 +439,1203 @@ func StandardPreparedUpdate(addresses []*types.Address, g *grammar.Grammar, tx *
 	}
 	return fn
 }
+
+// bulkInsertKey is the natural key the multi-row insert benchmarks return alongside the pk; neither database
+// promises RETURNING rows arrive in VALUES order so the rows are matched to their models by this key.
+type bulkInsertKey struct {
+	Street, City, State, Zip string
+}
+
+// bulkInserted holds the models of one multi-row insert by natural key; models with equal keys are
+// interchangeable and matched in order.
+type bulkInserted map[bulkInsertKey][]*types.Address
+
+// newBulkInserted indexes addresses by natural key.
+func newBulkInserted(addresses []*types.Address) bulkInserted {
+	rv := make(bulkInserted, len(addresses))
+	for _, address := range addresses {
+		key := bulkInsertKey{address.Street, address.City, address.State, address.Zip}
+		rv[key] = append(rv[key], address)
+	}
+	return rv
+}
+
+// take removes the model matching the natural key of returned, copies the returned pk and timestamps into
+// it, and checks it with PostInsert; b fails if no model is left for the key.
+func (me bulkInserted) take(b *testing.B, library string, returned *types.Address) {
+	key := bulkInsertKey{returned.Street, returned.City, returned.State, returned.Zip}
+	models := me[key]
+	if len(models) == 0 {
+		b.Fatalf("%v returned unknown row %+v", library, key)
+	}
+	address := models[0]
+	me[key] = models[1:]
+	address.Id, address.CreatedTime, address.ModifiedTime = returned.Id, returned.CreatedTime, returned.ModifiedTime
+	address.PostInsert(b)
+}
+
+// standardBulkUpdated scans the pk and modified_tmz returned by a set-based UPDATE into the matching models of
+// byPk; the rows may arrive in any order.
+func standardBulkUpdated(b *testing.B, rows *sql.Rows, byPk map[int]*types.Address) {
+	var pk, n int
+	var modified types.Time
+	var err error
+	for rows.Next() {
+		if err = rows.Scan(&pk, &modified); err != nil {
+			b.Fatalf("standard scan failed with %v", err.Error())
+		}
+		address, ok := byPk[pk]
+		if !ok {
+			b.Fatalf("standard returned unknown pk %v", pk)
+		}
+		address.ModifiedTime = modified
+		address.PostUpdate(b)
+		n++
+	}
+	if err = rows.Err(); err != nil {
+		b.Fatalf("standard rows.Err failed with %v", err.Error())
+	}
+	rows.Close()
+	if n != len(byPk) {
+		b.Fatalf("standard updated %v of %v rows", n, len(byPk))
+	}
+}
+
+// StandardUpdateFromValues performs one UPDATE ... FROM a VALUES list per iteration with the standard
+// database/sql package.
+func StandardUpdateFromValues(addresses []*types.Address, dialect Dialect, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		//
+		byPk := addressesByPk(addresses)
+		for k := 0; k < b.N; k++ {
+			query, args := standardUpdateFromValuesQuery(b, addresses, dialect)
+			if rows, err = tx.Query(query, args...); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkUpdated(b, rows, byPk)
+		}
+	}
+	return fn
+}
+
+// addressesByPk indexes addresses by pk for standardBulkUpdated.
+func addressesByPk(addresses []*types.Address) map[int]*types.Address {
+	rv := make(map[int]*types.Address, len(addresses))
+	for _, address := range addresses {
+		rv[address.Id] = address
+	}
+	return rv
+}
+
+// standardUpdateFromValuesQuery calls PreUpdate on addresses and returns the UPDATE of
+// StandardUpdateFromValues and its arguments.
+func standardUpdateFromValuesQuery(b *testing.B, addresses []*types.Address, dialect Dialect) (string, []interface{}) {
+	var query strings.Builder
+	args := make([]interface{}, 0, 5*len(addresses))
+	param := func() string {
+		if dialect == Postgres {
+			return "$" + strconv.Itoa(len(args)+1)
+		}
+		return "?"
+	}
+	query.WriteString("with v ( pk, street, city, state, zip ) as ( values ")
+	for j, address := range addresses {
+		address.PreUpdate(b)
+		if j > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("( cast( " + param() + " as integer )")
+		args = append(args, address.Id)
+		for _, value := range []string{address.Street, address.City, address.State, address.Zip} {
+			query.WriteString(", " + param())
+			args = append(args, value)
+		}
+		query.WriteString(" )")
+	}
+	query.WriteString(" ) update " + types.AddressTableName + " set")
+	query.WriteString(" street = v.street, city = v.city, state = v.state, zip = v.zip")
+	query.WriteString(" from v where " + types.AddressTableName + ".pk = v.pk")
+	query.WriteString(" returning " + types.AddressTableName + ".pk, " + types.AddressTableName + ".modified_tmz")
+	return query.String(), args
+}
+
+// StandardUpdateCase performs one UPDATE per iteration that sets each column with a CASE expression on pk
+// using the standard database/sql package.
+func StandardUpdateCase(addresses []*types.Address, dialect Dialect, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		//
+		byPk := addressesByPk(addresses)
+		for k := 0; k < b.N; k++ {
+			query, args := standardUpdateCaseQuery(b, addresses, dialect)
+			if rows, err = tx.Query(query, args...); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkUpdated(b, rows, byPk)
+		}
+	}
+	return fn
+}
+
+// standardUpdateCaseColumns are the columns StandardUpdateCase sets and their values in a model.
+var standardUpdateCaseColumns = []struct {
+	name  string
+	value func(*types.Address) string
+}{
+	{"street", func(a *types.Address) string { return a.Street }},
+	{"city", func(a *types.Address) string { return a.City }},
+	{"state", func(a *types.Address) string { return a.State }},
+	{"zip", func(a *types.Address) string { return a.Zip }},
+}
+
+// standardUpdateCaseQuery calls PreUpdate on addresses and returns the UPDATE of StandardUpdateCase and its
+// arguments.
+func standardUpdateCaseQuery(b *testing.B, addresses []*types.Address, dialect Dialect) (string, []interface{}) {
+	var query strings.Builder
+	args := make([]interface{}, 0, 9*len(addresses))
+	param := func() string {
+		if dialect == Postgres {
+			return "$" + strconv.Itoa(len(args)+1)
+		}
+		return "?"
+	}
+	for _, address := range addresses {
+		address.PreUpdate(b)
+	}
+	query.WriteString("update " + types.AddressTableName + " set ")
+	for c, column := range standardUpdateCaseColumns {
+		if c > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString(column.name + " = case pk")
+		for _, address := range addresses {
+			query.WriteString(" when " + param())
+			args = append(args, address.Id)
+			query.WriteString(" then " + param())
+			args = append(args, column.value(address))
+		}
+		query.WriteString(" end")
+	}
+	query.WriteString(" where pk in ( ")
+	for j, address := range addresses {
+		if j > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString(param())
+		args = append(args, address.Id)
+	}
+	query.WriteString(" ) returning pk, modified_tmz")
+	return query.String(), args
+}
+
+// StandardUpdateCopy performs UPDATEs by copying the models into a temporary table with pq.CopyIn and
+// joining it in a single UPDATE; Postgres only.
+func StandardUpdateCopy(addresses []*types.Address, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		//
+		byPk := addressesByPk(addresses)
+		temp, create, update := standardUpdateCopyQueries()
+		for k := 0; k < b.N; k++ {
+			if _, err = tx.Exec(create); err != nil {
+				b.Fatalf("create temporary table failed with %v", err.Error())
+			}
+			if stmt, err = tx.Prepare(pq.CopyIn(temp, "pk", "street", "city", "state", "zip")); err != nil {
+				b.Fatalf("error preparing copy with %v", err.Error())
+			}
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				if _, err = stmt.Exec(address.Id, address.Street, address.City, address.State, address.Zip); err != nil {
+					b.Fatalf("copy failed with %v", err.Error())
+				}
+			}
+			if _, err = stmt.Exec(); err != nil {
+				b.Fatalf("copy flush failed with %v", err.Error())
+			}
+			if err = stmt.Close(); err != nil {
+				b.Fatalf("copy close failed with %v", err.Error())
+			}
+			if rows, err = tx.Query(update); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkUpdated(b, rows, byPk)
+			if _, err = tx.Exec("drop table " + temp); err != nil {
+				b.Fatalf("drop temporary table failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// standardUpdateCopyQueries returns the temporary table of StandardUpdateCopy, the statement that creates it,
+// and the UPDATE that joins it.
+func standardUpdateCopyQueries() (temp string, create string, update string) {
+	temp = types.AddressTableName + "_tmp"
+	create = "create temporary table " + temp + " ( pk integer, street character varying, city character varying, state character varying, zip character varying )"
+	update = fmt.Sprintf(`
+		update %[1]v set
+			street = t.street, city = t.city, state = t.state, zip = t.zip
+		from %[2]v t
+		where %[1]v.pk = t.pk
+		returning %[1]v.pk, %[1]v.modified_tmz
+	`, types.AddressTableName, temp)
+	return temp, create, update
+}
+
+// StandardSelectWideSqlmock creates a test for selecting and scanning rows of columns columns with database/sql.
+func StandardSelectWideSqlmock(columns int, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d types.Wide
+		//
+		b.StopTimer()
+		wt := types.WideTypes[columns]
+		mockrows := wt.New().MockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			b.StartTimer()
+			//
+			rows, err = db.Query("select * from table")
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = wt.New()
+				err = rows.Scan(d.Pointers()...)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectWide creates a test for selecting and scanning rows of columns columns with database/sql.
+func StandardSelectWide(columns int, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d types.Wide
+		//
+		wt := types.WideTypes[columns]
+		query := WideSelectQuery(columns, limit)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = wt.New()
+				err = rows.Scan(d.Pointers()...)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectNullableSqlmock creates a test for selecting and scanning nullable rows with database/sql;
+// ratio of the values are NULL and kind is one of types.NullablePtr or types.NullableSql.
+func StandardSelectNullableSqlmock(kind string, ratio float64, limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d types.NullableAddress
+		//
+		b.StopTimer()
+		mockrows := types.NullableAddressMockRows(limit, ratio)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			b.StartTimer()
+			//
+			rows, err = db.Query("select * from table")
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = types.NewNullableAddress(kind)
+				err = rows.Scan(d.Pointers()...)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectNullable creates a test for selecting and scanning nullable rows with database/sql; kind is
+// one of types.NullablePtr or types.NullableSql.
+func StandardSelectNullable(kind string, limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d types.NullableAddress
+		//
+		query := NullableAddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = types.NewNullableAddress(kind)
+				err = rows.Scan(d.Pointers()...)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectKitchenSinkSqlmock creates a test for selecting and scanning rows of many column types with
+// database/sql.
+func StandardSelectKitchenSinkSqlmock(limit int, mock sqlmock.Sqlmock, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d *types.KitchenSink
+		//
+		b.StopTimer()
+		mockrows := types.KitchenSinkMockRows(limit)
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			b.StopTimer()
+			mock.ExpectQuery("select +").WillReturnRows(mockrows)
+			b.StartTimer()
+			//
+			rows, err = db.Query("select * from table")
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.KitchenSink{}
+				err = rows.Scan(d.Pointers()...)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectKitchenSink creates a test for selecting and scanning rows of many column types with
+// database/sql.
+func StandardSelectKitchenSink(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var d *types.KitchenSink
+		//
+		query := KitchenSinkQuery(limit)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.KitchenSink{}
+				err = rows.Scan(d.Pointers()...)
+			}
+			if err != nil {
+				b.Fatalf("database/sql scan failed with %v", err.Error())
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectStream creates a test for streaming rows one at a time with database/sql rows.Next into a single
+// destination; the peak heap is reported next to ns/op.
+func StandardSelectStream(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var n int
+		d := &types.Address{}
+		//
+		query := AddressQuery(limit)
+		heap := NewHeapPeak(b)
+		for k := 0; k < b.N; k++ {
+			rows, err = db.Query(query)
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for n = 0; rows.Next(); n++ {
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				if n%HeapSampleRows == 0 {
+					heap.Sample()
+				}
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if n != limit {
+				b.Fatalf("database/sql streamed %v of %v rows", n, limit)
+			}
+		}
+		heap.Report()
+	}
+	return fn
+}
+
+// StandardLookup creates a test for selecting one address by pk with QueryRow() -> row.Scan(); each
+// iteration looks up the next of ids.
+func StandardLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			err = db.QueryRow(query, id).Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+			if err != nil {
+				b.Fatalf("database/sql lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("database/sql lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// StandardPreparedLookup creates a test for selecting one address by pk with a prepared statement; each
+// iteration looks up the next of ids.
+func StandardPreparedLookup(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var err error
+		var d types.Address
+		//
+		if stmt, err = db.Prepare(AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			err = stmt.QueryRow(id).Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+			if err != nil {
+				b.Fatalf("database/sql lookup failed with %v", err.Error())
+			}
+			if d.Id != id {
+				b.Fatalf("database/sql lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// StandardPreparedSelect creates a test for selecting and scanning rows from a prepared statement with
+// database/sql.
+func StandardPreparedSelect(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		if stmt, err = db.Prepare(AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			rows, err = stmt.Query()
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+		}
+	}
+	return fn
+}
+
+// StandardSelectIn creates a test for selecting the addresses with pk in ids with database/sql; the IN list
+// is expanded by hand into one placeholder per id on every iteration.
+func StandardSelectIn(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		var list strings.Builder
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			list.Reset()
+			args := make([]interface{}, len(ids))
+			for n, id := range ids {
+				if n > 0 {
+					list.WriteString(", ")
+				}
+				if dialect == Postgres {
+					list.WriteString("$" + strconv.Itoa(n+1))
+				} else {
+					list.WriteString("?")
+				}
+				args[n] = id
+			}
+			rows, err = db.Query(AddressInQuery(list.String()), args...)
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if len(dest) != len(ids) {
+				b.Fatalf("database/sql selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// StandardSelectAny creates a test for selecting the addresses with pk in ids with database/sql and
+// Postgres `pk = ANY($1)` binding ids with pq.Array.
+func StandardSelectAny(ids []int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		query := fmt.Sprintf(`
+			select
+				pk, created_tmz, modified_tmz,
+				street, city, state, zip
+			from %v
+			where pk = any($1)
+		`, types.AddressTableName)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			rows, err = db.Query(query, pq.Array(ids))
+			if err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			if len(dest) != len(ids) {
+				b.Fatalf("database/sql selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// StandardSelectContext creates a test for selecting and scanning rows with database/sql; every query has a
+// deadline of ContextTimeout.
+func StandardSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		query := AddressQuery(limit)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = db.QueryContext(ctx, query); err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardInsertContext is StandardInsert with a deadline of ContextTimeout on every INSERT.
+func StandardInsertContext(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := `
+			insert into %v ( street, city, state, zip )
+			values ( ?, ?, ?, ? )
+			returning pk, created_tmz, modified_tmz
+		`
+		if dialect == Postgres {
+			query = `
+				insert into %v ( street, city, state, zip )
+				values ( $1, $2, $3, $4 )
+				returning pk, created_tmz, modified_tmz
+			`
+		}
+		query = fmt.Sprintf(query, types.AddressTableName)
+		//
+		var row *sql.Row
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreInsert(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				row = db.QueryRowContext(ctx, query, address.Street, address.City, address.State, address.Zip)
+				if err = row.Scan(&address.Id, &address.CreatedTime, &address.ModifiedTime); err != nil {
+					b.Fatalf("standard failed with %v", err.Error())
+				}
+				cancel()
+				//
+				address.PostInsert(b)
+			}
+		}
+	}
+	return fn
+}
+
+// StandardUpdateContext is StandardUpdate with a deadline of ContextTimeout on every UPDATE.
+func StandardUpdateContext(addresses []*types.Address, dialect Dialect, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		query := `
+			update %v set
+				street = ?, city = ?, state = ?, zip = ?
+			where pk = ?
+			returning modified_tmz
+		`
+		if dialect == Postgres {
+			query = `
+				update %v set
+					street = $1, city = $2, state = $3, zip = $4
+				where pk = $5
+				returning modified_tmz
+			`
+		}
+		query = fmt.Sprintf(query, types.AddressTableName)
+		//
+		var row *sql.Row
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				//
+				ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+				row = tx.QueryRowContext(ctx, query, address.Street, address.City, address.State, address.Zip, address.Id)
+				if err = row.Scan(&address.ModifiedTime); err != nil {
+					b.Fatalf("standard failed with %v", err.Error())
+				}
+				cancel()
+				//
+				address.PostUpdate(b)
+			}
+		}
+	}
+	return fn
+}
+
+// StandardLookupContext is StandardLookup using QueryRowContext with a deadline of ContextTimeout on every
+// lookup.
+func StandardLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var err error
+		var d types.Address
+		//
+		query := AddressByPkQuery(dialect)
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			err = db.QueryRowContext(ctx, query, id).Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+			if err != nil {
+				b.Fatalf("database/sql lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("database/sql lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// StandardPreparedLookupContext is StandardPreparedLookup using PrepareContext and QueryRowContext; the
+// prepare and every lookup have a deadline of ContextTimeout.
+func StandardPreparedLookupContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var err error
+		var d types.Address
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = db.PrepareContext(ctx, AddressByPkQuery(dialect)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			id := ids[k%len(ids)]
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			err = stmt.QueryRowContext(ctx, id).Scan(
+				&d.Id, &d.CreatedTime, &d.ModifiedTime,
+				&d.Street, &d.City, &d.State, &d.Zip,
+			)
+			if err != nil {
+				b.Fatalf("database/sql lookup failed with %v", err.Error())
+			}
+			cancel()
+			if d.Id != id {
+				b.Fatalf("database/sql lookup of %v returned %v", id, d.Id)
+			}
+		}
+	}
+	return fn
+}
+
+// StandardPreparedSelectContext is StandardPreparedSelect using PrepareContext and QueryContext; the prepare
+// and every query have a deadline of ContextTimeout.
+func StandardPreparedSelectContext(limit int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+		if stmt, err = db.PrepareContext(ctx, AddressQuery(limit)); err != nil {
+			b.Fatalf("error preparing statement with %v", err.Error())
+		}
+		cancel()
+		defer stmt.Close()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = stmt.QueryContext(ctx); err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardSelectInContext is StandardSelectIn using QueryContext with a deadline of ContextTimeout on every
+// query.
+func StandardSelectInContext(ids []int, dialect Dialect, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		var list strings.Builder
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			list.Reset()
+			args := make([]interface{}, len(ids))
+			for n, id := range ids {
+				if n > 0 {
+					list.WriteString(", ")
+				}
+				if dialect == Postgres {
+					list.WriteString("$" + strconv.Itoa(n+1))
+				} else {
+					list.WriteString("?")
+				}
+				args[n] = id
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = db.QueryContext(ctx, AddressInQuery(list.String()), args...); err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+			if len(dest) != len(ids) {
+				b.Fatalf("database/sql selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// StandardSelectAnyContext is StandardSelectAny using QueryContext with a deadline of ContextTimeout on every
+// query.
+func StandardSelectAnyContext(ids []int, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		var dest []*types.Address
+		var d *types.Address
+		//
+		query := fmt.Sprintf(`
+			select
+				pk, created_tmz, modified_tmz,
+				street, city, state, zip
+			from %v
+			where pk = any($1)
+		`, types.AddressTableName)
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = db.QueryContext(ctx, query, pq.Array(ids)); err != nil {
+				b.Fatalf("database/sql query failed with %v", err.Error())
+			}
+			for rows.Next() {
+				d = &types.Address{}
+				if err = rows.Scan(
+					&d.Id, &d.CreatedTime, &d.ModifiedTime,
+					&d.Street, &d.City, &d.State, &d.Zip,
+				); err != nil {
+					b.Fatalf("database/sql scan failed with %v", err.Error())
+				}
+				dest = append(dest, d)
+			}
+			if err = rows.Err(); err != nil {
+				b.Fatalf("database/sql rows.Err failed with %v", err.Error())
+			}
+			rows.Close()
+			cancel()
+			if len(dest) != len(ids) {
+				b.Fatalf("database/sql selected %v of %v ids", len(dest), len(ids))
+			}
+		}
+	}
+	return fn
+}
+
+// StandardBulkInsertContext is StandardBulkInsert using QueryContext with a deadline of ContextTimeout on
+// every INSERT.
+func StandardBulkInsertContext(addresses []*types.Address, dialect Dialect, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			query, args := standardBulkInsertQuery(b, addresses, dialect)
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = db.QueryContext(ctx, query, args...); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkInserted(b, rows, addresses)
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardCopyInsertContext is StandardCopyInsert with the transaction begun by BeginTx; the transaction
+// and its COPY have a deadline of ContextTimeout.
+func StandardCopyInsertContext(addresses []*types.Address, db *sql.DB) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var tx *sql.Tx
+		var stmt *sql.Stmt
+		var err error
+		//
+		for k := 0; k < b.N; k++ {
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if tx, err = db.BeginTx(ctx, nil); err != nil {
+				b.Fatalf("error beginning transaction with %v", err.Error())
+			}
+			if stmt, err = tx.PrepareContext(ctx, pq.CopyIn(types.AddressTableName, "street", "city", "state", "zip")); err != nil {
+				b.Fatalf("error preparing copy with %v", err.Error())
+			}
+			for _, address := range addresses {
+				if _, err = stmt.ExecContext(ctx, address.Street, address.City, address.State, address.Zip); err != nil {
+					b.Fatalf("copy failed with %v", err.Error())
+				}
+			}
+			if _, err = stmt.ExecContext(ctx); err != nil {
+				b.Fatalf("copy flush failed with %v", err.Error())
+			}
+			if err = stmt.Close(); err != nil {
+				b.Fatalf("copy close failed with %v", err.Error())
+			}
+			if err = tx.Commit(); err != nil {
+				b.Fatalf("error durring commit with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardUpdateFromValuesContext is StandardUpdateFromValues using QueryContext with a deadline of
+// ContextTimeout on every UPDATE.
+func StandardUpdateFromValuesContext(addresses []*types.Address, dialect Dialect, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		//
+		byPk := addressesByPk(addresses)
+		for k := 0; k < b.N; k++ {
+			query, args := standardUpdateFromValuesQuery(b, addresses, dialect)
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = tx.QueryContext(ctx, query, args...); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkUpdated(b, rows, byPk)
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardUpdateCaseContext is StandardUpdateCase using QueryContext with a deadline of ContextTimeout on
+// every UPDATE.
+func StandardUpdateCaseContext(addresses []*types.Address, dialect Dialect, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var rows *sql.Rows
+		var err error
+		//
+		byPk := addressesByPk(addresses)
+		for k := 0; k < b.N; k++ {
+			query, args := standardUpdateCaseQuery(b, addresses, dialect)
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if rows, err = tx.QueryContext(ctx, query, args...); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkUpdated(b, rows, byPk)
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardUpdateCopyContext is StandardUpdateCopy using the ...Context methods; the statements of each
+// iteration share a deadline of ContextTimeout since the temporary table only lives that long.
+func StandardUpdateCopyContext(addresses []*types.Address, tx *sql.Tx) func(b *testing.B) {
+	fn := func(b *testing.B) {
+		var stmt *sql.Stmt
+		var rows *sql.Rows
+		var err error
+		//
+		byPk := addressesByPk(addresses)
+		temp, create, update := standardUpdateCopyQueries()
+		for k := 0; k < b.N; k++ {
+			ctx, cancel := context.WithTimeout(context.Background(), ContextTimeout)
+			if _, err = tx.ExecContext(ctx, create); err != nil {
+				b.Fatalf("create temporary table failed with %v", err.Error())
+			}
+			if stmt, err = tx.PrepareContext(ctx, pq.CopyIn(temp, "pk", "street", "city", "state", "zip")); err != nil {
+				b.Fatalf("error preparing copy with %v", err.Error())
+			}
+			for _, address := range addresses {
+				address.PreUpdate(b)
+				if _, err = stmt.ExecContext(ctx, address.Id, address.Street, address.City, address.State, address.Zip); err != nil {
+					b.Fatalf("copy failed with %v", err.Error())
+				}
+			}
+			if _, err = stmt.ExecContext(ctx); err != nil {
+				b.Fatalf("copy flush failed with %v", err.Error())
+			}
+			if err = stmt.Close(); err != nil {
+				b.Fatalf("copy close failed with %v", err.Error())
+			}
+			if rows, err = tx.QueryContext(ctx, update); err != nil {
+				b.Fatalf("standard failed with %v", err.Error())
+			}
+			standardBulkUpdated(b, rows, byPk)
+			if _, err = tx.ExecContext(ctx, "drop table "+temp); err != nil {
+				b.Fatalf("drop temporary table failed with %v", err.Error())
+			}
+			cancel()
+		}
+	}
+	return fn
+}
+
+// StandardCancelSelector returns a ContextSelector that scans rows with database/sql.
+func StandardCancelSelector(db *sql.DB) ContextSelector {
+	return func(ctx context.Context, limit int, dest *[]*types.CancelAddress) error {
+		rows, err := db.QueryContext(ctx, CancelAddressQuery(limit))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			d := &types.CancelAddress{}
+			if err = rows.Scan(d.Pointers()...); err != nil {
+				return err
+			}
+			*dest = append(*dest, d)
+		}
+		return rows.Err()
+	}
+}
+
+// StandardErrorOps returns the error path operations of the standard database/sql package; stored is an
+// address already in the table.
+func StandardErrorOps(stored *types.Address, dialect Dialect, db *sql.DB) ErrorOps {
+	insert := StandardTxInserter(dialect)
+	update := `
+		update %v set
+			street = ?, city = ?, state = ?, zip = ?
+		where pk = ?
+		returning modified_tmz
+	`
+	if dialect == Postgres {
+		update = `
+			update %v set
+				street = $1, city = $2, state = $3, zip = $4
+			where pk = $5
+			returning modified_tmz
+		`
+	}
+	update = fmt.Sprintf(update, types.AddressTableName)
+	scan := func(row *sql.Row) error {
+		d := &types.Address{}
+		return row.Scan(
+			&d.Id, &d.CreatedTime, &d.ModifiedTime,
+			&d.Street, &d.City, &d.State, &d.Zip,
+		)
+	}
+	return ErrorOps{
+		ErrorDuplicateKey: func() error {
+			d := *stored
+			return insert(db, &d)
+		},
+		ErrorMissingRow: func() error {
+			d := *stored
+			return db.QueryRow(update, d.Street, d.City, d.State, d.Zip, ErrorMissingPk).Scan(&d.ModifiedTime)
+		},
+		ErrorUnmappedColumn: func() error {
+			return scan(db.QueryRow(ErrorUnmappedQuery()))
+		},
+		ErrorTypeMismatch: func() error {
+			return scan(db.QueryRow(ErrorTypeMismatchQuery()))
+		},
+		ErrorEmptyGet: func() error {
+			return scan(db.QueryRow(AddressByPkQuery(dialect), ErrorMissingPk))
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package sqlhbenchmarks_test
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// Reports are printed once by TestMain after every benchmark has run; a benchmark stores its report under a
+// title, replacing the report of an earlier run.
+var Reports = map[string]fmt.Stringer{}
+
+// MinFixtureAddresses is the number of addresses the insert and update benchmarks slice into.
+const MinFixtureAddresses = 1000
+
+func TestMain(m *testing.M) {
+	env := "TEST_FIXTURES"
+	if dir := os.Getenv(env); dir != "" {
+		if err := types.LoadFixtureDir(dir); err != nil {
+			fmt.Fprintf(os.Stderr, "loading %v failed with %v\n", env, err.Error())
+			os.Exit(1)
+		} else if n := len(types.AddressRecords()); n < MinFixtureAddresses {
+			fmt.Fprintf(os.Stderr, "%v needs at least %v addresses; found %v\n", env, MinFixtureAddresses, n)
+			os.Exit(1)
+		}
+	}
+	code := m.Run()
+	titles := []string{}
+	for title := range Reports {
+		titles = append(titles, title)
+	}
+	sort.Strings(titles)
+	for _, title := range titles {
+		fmt.Printf("%v:\n%v\n", title, Reports[title])
+	}
+	os.Exit(code)
+}
@@ -0,0 +1,74 @@
+package sqlhbenchmarks
+
+import (
+	"fmt"
+
+	"github.com/nofeaturesonlybugs/set"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// TagsMapper returns types.NewMapper() with its Tags replaced by tags; with no tags fields are mapped by
+// their names.
+func TagsMapper(tags ...string) *set.Mapper {
+	rv := types.NewMapper()
+	rv.Tags = tags
+	return rv
+}
+
+// MapperConfig is a set.Mapper configuration swept by the mapper benchmarks along with the destination and
+// column names it scans.
+type MapperConfig struct {
+	Name string
+	// Mapper returns a new mapper with the configuration.
+	Mapper func() *set.Mapper
+	// Dest returns the address of a nil slice of the type scanned.
+	Dest func() interface{}
+	// Columns are the names the address table columns are selected as.
+	Columns []string
+}
+
+// Query returns the query that selects limit rows from the address table with the columns named by
+// Columns.
+func (me MapperConfig) Query(limit int) string {
+	return fmt.Sprintf(`
+		select
+			pk as "%v", created_tmz as "%v", modified_tmz as "%v",
+			street as "%v", city as "%v", state as "%v", zip as "%v"
+		from %v
+		order by pk
+		limit %v
+	`,
+		me.Columns[0], me.Columns[1], me.Columns[2],
+		me.Columns[3], me.Columns[4], me.Columns[5], me.Columns[6],
+		types.AddressTableName, limit,
+	)
+}
+
+// MapperConfigs are the configurations compared by the mapper benchmarks.  Except for Tags and the
+// destination of the deep join they are types.NewMapper().
+var MapperConfigs = []MapperConfig{
+	{
+		Name:    "tags db,json",
+		Mapper:  types.NewMapper,
+		Dest:    func() interface{} { return &[]*types.Address{} },
+		Columns: []string{"pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip"},
+	},
+	{
+		Name:    "tag json",
+		Mapper:  func() *set.Mapper { return TagsMapper("json") },
+		Dest:    func() interface{} { return &[]*types.Address{} },
+		Columns: []string{"id", "created_time", "modified_time", "street", "city", "state", "zip"},
+	},
+	{
+		Name:    "no tags",
+		Mapper:  func() *set.Mapper { return TagsMapper() },
+		Dest:    func() interface{} { return &[]*types.Address{} },
+		Columns: []string{"Id", "CreatedTime", "ModifiedTime", "Street", "City", "State", "Zip"},
+	},
+	{
+		Name:    "deep join",
+		Mapper:  types.NewMapper,
+		Dest:    func() interface{} { return &[]*types.DeepAddress{} },
+		Columns: types.DeepAddressColumns(types.NewMapper().Join),
+	},
+}
@@ -0,0 +1,196 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// MismatchCase is a select whose columns differ from those types.Address maps.
+type MismatchCase struct {
+	Name string
+	// Columns is the select list.
+	Columns string
+	// Fields are the columns of the address table that Columns selects, in any form.
+	Fields []string
+}
+
+// MismatchCases are the column mismatches compared by the mismatch benchmarks.  The first case selects
+// exactly the mapped columns; it is the control, and a library that fails it is misconfigured rather than
+// strict.
+var MismatchCases = []MismatchCase{
+	{
+		Name:    "exact columns",
+		Columns: `pk, created_tmz, modified_tmz, street, city, state, zip`,
+		Fields:  []string{"pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip"},
+	},
+	{
+		Name:    "extra columns",
+		Columns: `pk, created_tmz, modified_tmz, street, city, state, zip, 'x' as unmapped, 0 as extra_number`,
+		Fields:  []string{"pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip"},
+	},
+	{
+		Name:    "missing columns",
+		Columns: `pk, created_tmz, modified_tmz, street`,
+		Fields:  []string{"pk", "created_tmz", "modified_tmz", "street"},
+	},
+	{
+		Name:    "cased names",
+		Columns: `pk as "PK", created_tmz as "Created_Tmz", modified_tmz as "MODIFIED_TMZ", street as "Street", city as "CITY", state as "State", zip as "Zip"`,
+		Fields:  []string{"pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip"},
+	},
+	{
+		Name:    "json names",
+		Columns: `pk as id, created_tmz as created_time, modified_tmz as modified_time, street, city, state, zip`,
+		Fields:  []string{"pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip"},
+	},
+}
+
+// Query returns the select of limit rows for the case.
+func (me MismatchCase) Query(limit int) string {
+	return fmt.Sprintf("select %v from %v order by pk limit %v", me.Columns, types.AddressTableName, limit)
+}
+
+// Mismatch outcomes.
+const (
+	// MismatchError is an error returned by the library.
+	MismatchError = "error"
+	// MismatchIgnore is a scan where every selected value arrived and anything unmatched was ignored.
+	MismatchIgnore = "ignore"
+	// MismatchZero is a scan without error that left selected values at their zero value.
+	MismatchZero = "zero"
+	// MismatchRows is a scan without error that returned a different number of rows than were selected.
+	MismatchRows = "rows"
+)
+
+// MismatchSelector selects query into dest.
+type MismatchSelector func(query string, dest *[]*types.Address) error
+
+// MismatchMatrix collects the outcome of each library for each MismatchCase.
+type MismatchMatrix struct {
+	want     []*types.Address
+	outcomes map[string]map[string]string
+}
+
+// NewMismatchMatrix creates a MismatchMatrix whose expected rows are the first limit addresses by pk.
+func NewMismatchMatrix(limit int, db *sql.DB) (*MismatchMatrix, error) {
+	rv := &MismatchMatrix{outcomes: map[string]map[string]string{}}
+	rows, err := db.Query(AddressQuery(limit))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		d := &types.Address{}
+		if err = rows.Scan(
+			&d.Id, &d.CreatedTime, &d.ModifiedTime,
+			&d.Street, &d.City, &d.State, &d.Zip,
+		); err != nil {
+			return nil, err
+		}
+		rv.want = append(rv.want, d)
+	}
+	return rv, rows.Err()
+}
+
+// classify returns the outcome of a select for c; fields in unmapped are not compared.
+func (me *MismatchMatrix) classify(c MismatchCase, unmapped []string, dest []*types.Address, err error) string {
+	if err != nil {
+		return MismatchError
+	} else if len(dest) != len(me.want) {
+		return MismatchRows
+	}
+	for k, want := range me.want {
+		got := dest[k]
+	fields:
+		for _, field := range c.Fields {
+			for _, skip := range unmapped {
+				if field == skip {
+					continue fields
+				}
+			}
+			var same bool
+			switch field {
+			case "pk":
+				same = got.Id == want.Id
+			case "created_tmz":
+				same = got.CreatedTime.Equal(want.CreatedTime.Time)
+			case "modified_tmz":
+				same = got.ModifiedTime.Equal(want.ModifiedTime.Time)
+			case "street":
+				same = got.Street == want.Street
+			case "city":
+				same = got.City == want.City
+			case "state":
+				same = got.State == want.State
+			case "zip":
+				same = got.Zip == want.Zip
+			}
+			if !same {
+				return MismatchZero
+			}
+		}
+	}
+	return MismatchIgnore
+}
+
+// Select creates a test that selects c with selector; the outcome of the first select is recorded for
+// library and reported as a metric of 1 named for the outcome.  unmapped are the fields the library never
+// maps for types.Address, which are not compared.
+func (me *MismatchMatrix) Select(library string, c MismatchCase, selector MismatchSelector, unmapped ...string) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var dest []*types.Address
+		var err error
+		query := c.Query(len(me.want))
+		//
+		b.StopTimer()
+		err = selector(query, &dest)
+		outcome := me.classify(c, unmapped, dest, err)
+		if me.outcomes[library] == nil {
+			me.outcomes[library] = map[string]string{}
+		}
+		me.outcomes[library][c.Name] = outcome
+		b.StartTimer()
+		//
+		for k := 0; k < b.N; k++ {
+			dest = nil // Reset dest
+			selector(query, &dest)
+		}
+		b.ReportMetric(1, outcome)
+	}
+	return fn
+}
+
+// String returns the outcomes as a table with one row per library and one column per MismatchCase.
+func (me *MismatchMatrix) String() string {
+	libraries := []string{}
+	for library := range me.outcomes {
+		libraries = append(libraries, library)
+	}
+	sort.Strings(libraries)
+	width := len("library")
+	for _, library := range libraries {
+		if len(library) > width {
+			width = len(library)
+		}
+	}
+	//
+	row := func(name string, cell func(MismatchCase) string) string {
+		var rv strings.Builder
+		fmt.Fprintf(&rv, "%-*v", width, name)
+		for _, c := range MismatchCases {
+			fmt.Fprintf(&rv, " | %-15v", cell(c))
+		}
+		return strings.TrimRight(rv.String(), " ")
+	}
+	rows := []string{row("library", func(c MismatchCase) string { return c.Name })}
+	for _, library := range libraries {
+		outcomes := me.outcomes[library]
+		rows = append(rows, row(library, func(c MismatchCase) string { return outcomes[c.Name] }))
+	}
+	return strings.Join(rows, "\n")
+}
@@ -0,0 +1,59 @@
+package sqlhbenchmarks
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// Order runs the per-library sub-benchmarks of a benchmark either in the order they are written or
+// shuffled by a seeded random source.
+//
+// Shuffling is controlled by the TEST_ORDER_SEED environment variable:
+//
+//	unset or empty	libraries run in the order given
+//	random		a seed is generated from the current time
+//	integer		the integer is used as the seed
+//
+// The seed is printed as an "order-seed" configuration line in the benchmark output so a shuffled run can
+// be repeated and results can be grouped by ordering.
+type Order struct {
+	rng *rand.Rand
+}
+
+// NewOrder creates an Order for b from the TEST_ORDER_SEED environment variable.
+func NewOrder(b *testing.B) *Order {
+	env := "TEST_ORDER_SEED"
+	rv := &Order{}
+	var seed int64
+	var err error
+	switch value := os.Getenv(env); value {
+	case "":
+		return rv
+	case "random":
+		seed = time.Now().UnixNano()
+	default:
+		if seed, err = strconv.ParseInt(value, 10, 64); err != nil {
+			b.Fatalf("%v is not an integer or \"random\": %v", env, err.Error())
+		}
+	}
+	fmt.Printf("order-seed: %v\n", seed)
+	rv.rng = rand.New(rand.NewSource(seed))
+	return rv
+}
+
+// Run calls each of runs once; when the Order is seeded the calls are made in shuffled order.  Each call
+// to Run draws a new permutation, so call it once per row count to vary the order per row count.
+func (me *Order) Run(runs ...func()) {
+	if me.rng != nil {
+		me.rng.Shuffle(len(runs), func(i, j int) {
+			runs[i], runs[j] = runs[j], runs[i]
+		})
+	}
+	for _, run := range runs {
+		run()
+	}
+}
@@ -2,16 +2,193 @@ package sqlhbenchmarks
 
 import (
 	"database/sql"
+	"fmt"
 	"strings"
 
+	"github.com/nofeaturesonlybugs/errors"
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
 )
 
-var (
-	// SchemaLibpq is the slice of queries needed to init the postgres schema.
-	SchemaLibpq = []string{
-		`DROP TABLE IF EXISTS {TABLE}`,
-		`CREATE TABLE {TABLE} (
+// Dialect identifies the database a Schema is written for.
+type Dialect string
+
+const (
+	Postgres Dialect = "postgres"
+	Sqlite   Dialect = "sqlite"
+)
+
+// TimeEncodings are the encodings of types.Time set by ConnectLibpq and ConnectSqlite for their dialect.
+var TimeEncodings = map[Dialect]types.TimeEncoding{
+	Postgres: types.TimeNative,
+	Sqlite:   types.TimeText,
+}
+
+// AddressQuery returns the query that selects limit rows from the address table.
+func AddressQuery(limit int) string {
+	return fmt.Sprintf(`
+		select
+			pk, created_tmz, modified_tmz,
+			street, city, state, zip
+		from %v
+		order by pk
+		limit %v
+	`, types.AddressTableName, limit)
+}
+
+// AddressByPkQuery returns the query that selects one row from the address table by pk with the
+// placeholder of dialect.
+func AddressByPkQuery(dialect Dialect) string {
+	placeholder := "?"
+	if dialect == Postgres {
+		placeholder = "$1"
+	}
+	return fmt.Sprintf(`
+		select
+			pk, created_tmz, modified_tmz,
+			street, city, state, zip
+		from %v
+		where pk = %v
+	`, types.AddressTableName, placeholder)
+}
+
+// AddressInQuery returns the query that selects the addresses whose pk is in list; list is the text
+// between the parentheses of the IN clause.
+func AddressInQuery(list string) string {
+	return fmt.Sprintf(`
+		select
+			pk, created_tmz, modified_tmz,
+			street, city, state, zip
+		from %v
+		where pk in ( %v )
+	`, types.AddressTableName, list)
+}
+
+// Model names are the keys used to register and look up schemas.
+const (
+	ModelAddress       = "address"
+	ModelCustomer      = "customer"
+	ModelVendor        = "vendor"
+	ModelVendorContact = "vendor_contact"
+	ModelSale          = "sale"
+	//
+	ModelNullableAddress = "nullable_address"
+	ModelKitchenSink     = "kitchen_sink"
+)
+
+// SalesModels are the models behind SaleReport in the order they must be created.
+var SalesModels = []string{ModelCustomer, ModelVendor, ModelVendorContact, ModelSale}
+
+// SchemaStep selects which statements of a Schema are executed by ExecSchema.
+type SchemaStep int
+
+const (
+	// StepCreate runs the Create statements.
+	StepCreate SchemaStep = iota
+	// StepDrop runs the Drop statements.
+	StepDrop
+	// StepTruncate runs the Truncate statements; they remove all rows and restart key sequences.
+	StepTruncate
+	// StepReset runs the Drop statements followed by the Create statements.
+	StepReset
+)
+
+// Schema is the set of statements needed to manage a single table in a single dialect.
+//
+// Statements may contain the {TABLE} placeholder; it is replaced with the value returned by
+// Table when the statement is executed.  Tables of other models are referenced with {TABLE:model}.
+type Schema struct {
+	Table    func() string
+	Create   []string
+	Drop     []string
+	Truncate []string
+}
+
+// schemaKey is the registry key for a Schema.
+type schemaKey struct {
+	Model   string
+	Dialect Dialect
+}
+
+// schemas is the schema registry; see RegisterSchema.
+var schemas = map[schemaKey]Schema{}
+
+// RegisterSchema adds schema to the registry for the model and dialect pair; an existing
+// registration is replaced.
+func RegisterSchema(model string, dialect Dialect, schema Schema) {
+	schemas[schemaKey{Model: model, Dialect: dialect}] = schema
+}
+
+// LookupSchema returns the Schema registered for the model and dialect pair.
+func LookupSchema(model string, dialect Dialect) (Schema, error) {
+	schema, ok := schemas[schemaKey{Model: model, Dialect: dialect}]
+	if !ok {
+		return schema, errors.Errorf("no schema registered for model %v and dialect %v", model, dialect)
+	}
+	return schema, nil
+}
+
+// Statements returns the queries for step with the table placeholders replaced.
+func (me Schema) Statements(step SchemaStep) []string {
+	var queries []string
+	switch step {
+	case StepCreate:
+		queries = me.Create
+	case StepDrop:
+		queries = me.Drop
+	case StepTruncate:
+		queries = me.Truncate
+	case StepReset:
+		queries = append(append([]string{}, me.Drop...), me.Create...)
+	}
+	replacements := []string{"{TABLE}", me.Table()}
+	for key, schema := range schemas {
+		replacements = append(replacements, "{TABLE:"+key.Model+"}", schema.Table())
+	}
+	replacer := strings.NewReplacer(replacements...)
+	rv := make([]string, len(queries))
+	for k, query := range queries {
+		rv[k] = replacer.Replace(query)
+	}
+	return rv
+}
+
+// ExecSchema runs the statements for step against db for each of the models.  Create and truncate
+// statements run in the order models are given; drop statements run in reverse order so tables are
+// dropped before the tables they reference.  StepReset drops every table before creating any.
+func ExecSchema(db *sql.DB, dialect Dialect, step SchemaStep, models ...string) error {
+	switch step {
+	case StepReset:
+		if err := ExecSchema(db, dialect, StepDrop, models...); err != nil {
+			return err
+		}
+		return ExecSchema(db, dialect, StepCreate, models...)
+
+	case StepDrop:
+		reversed := make([]string, len(models))
+		for k, model := range models {
+			reversed[len(models)-1-k] = model
+		}
+		models = reversed
+	}
+	for _, model := range models {
+		schema, err := LookupSchema(model, dialect)
+		if err != nil {
+			return err
+		}
+		for _, query := range schema.Statements(step) {
+			if _, err = db.Exec(query); err != nil {
+				return errors.Errorf("%v schema for %v: %v", dialect, model, err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func init() {
+	RegisterSchema(ModelAddress, Postgres, Schema{
+		Table: func() string { return types.AddressTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
 			pk serial primary key,
 			created_tmz timestamp (6) with time zone not null,
 			modified_tmz timestamp (6) with time zone not null,
@@ -20,7 +197,7 @@ var (
 			state character varying not null,
 			zip character varying not null
 		)`,
-		`create or replace function trg_addresses_insert() returns trigger as $BODY$ 
+			`create or replace function trg_{TABLE}_insert() returns trigger as $BODY$
 declare
 begin
 	new.created_tmz = now();
@@ -28,7 +205,7 @@ begin
 	return new;
 end;
 $BODY$ language plpgsql`,
-		`create or replace function trg_addresses_update() returns trigger as $BODY$
+			`create or replace function trg_{TABLE}_update() returns trigger as $BODY$
 declare
 begin
 	new.modified_tmz = now();
@@ -36,34 +213,39 @@ begin
 	return new;
 end;
 $BODY$ language plpgsql`,
-		`create trigger trg_addresses_insert before insert on {TABLE}
-for each row execute procedure trg_addresses_insert()`,
-		`create trigger trg_addresses_update before update on {TABLE}
-for each row execute procedure trg_addresses_update()`,
-	}
-	// SchemaSqlite is the slice of queries needed to init the sqlite schema.
-	SchemaSqlite = []string{
-		`DROP TABLE IF EXISTS {TABLE}`,
-		`CREATE TABLE {TABLE} (
-			pk integer primary key,
-			created_tmz datetime not null default (strftime('%Y-%m-%d %H:%M:%S', 'now', 'utc')),
-			modified_tmz datetime not null default (strftime('%Y-%m-%d %H:%M:%S', 'now', 'utc')),
+			`create trigger trg_{TABLE}_insert before insert on {TABLE}
+for each row execute procedure trg_{TABLE}_insert()`,
+			`create trigger trg_{TABLE}_update before update on {TABLE}
+for each row execute procedure trg_{TABLE}_update()`,
+		},
+		Drop: []string{
+			`DROP TABLE IF EXISTS {TABLE}`,
+			`DROP FUNCTION IF EXISTS trg_{TABLE}_insert()`,
+			`DROP FUNCTION IF EXISTS trg_{TABLE}_update()`,
+		},
+		Truncate: []string{
+			`TRUNCATE TABLE {TABLE} RESTART IDENTITY`,
+		},
+	})
+	RegisterSchema(ModelAddress, Sqlite, Schema{
+		Table: func() string { return types.AddressTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key autoincrement,
+			created_tmz datetime not null default (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+			modified_tmz datetime not null default (strftime('%Y-%m-%d %H:%M:%f', 'now')),
 			street text not null,
 			city text not null,
 			state text not null,
 			zip text not null
 		)`,
-	}
-)
-
-// ExecSchema runs statements to init the schema for tests against db.
-func ExecSchema(queries []string, db *sql.DB) error {
-	var err error
-	for _, query := range queries {
-		query = strings.Replace(query, "{TABLE}", types.AddressTableName, -1)
-		if _, err = db.Exec(query); err != nil {
-			return err
-		}
-	}
-	return nil
+		},
+		Drop: []string{
+			`DROP TABLE IF EXISTS {TABLE}`,
+		},
+		Truncate: []string{
+			`DELETE FROM {TABLE}`,
+			`DELETE FROM sqlite_sequence WHERE name = '{TABLE}'`,
+		},
+	})
 }
@@ -0,0 +1,96 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// KitchenSinkQuery returns the query that selects limit rows from the kitchen sink table.
+func KitchenSinkQuery(limit int) string {
+	return fmt.Sprintf(
+		"select %v from %v order by pk limit %v",
+		strings.Join(types.KitchenSinkColumns, ", "), types.KitchenSinkTableName, limit,
+	)
+}
+
+// SeedKitchenSinks inserts the rows of types.KitchenSinkRows into the kitchen sink table.
+func SeedKitchenSinks(rows int, dialect Dialect, db *sql.DB) error {
+	var tx *sql.Tx
+	var stmt *sql.Stmt
+	var err error
+	//
+	params := make([]string, len(types.KitchenSinkColumns))
+	for k := range params {
+		switch dialect {
+		case Postgres:
+			params[k] = fmt.Sprintf("$%v", k+1)
+		default:
+			params[k] = "?"
+		}
+	}
+	query := fmt.Sprintf(
+		"insert into %v ( %v ) values ( %v )",
+		types.KitchenSinkTableName, strings.Join(types.KitchenSinkColumns, ", "), strings.Join(params, ", "),
+	)
+	//
+	if tx, err = db.Begin(); err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if stmt, err = tx.Prepare(query); err != nil {
+		return err
+	}
+	defer stmt.Close()
+	args := make([]interface{}, len(params))
+	for _, row := range types.KitchenSinkRows(rows) {
+		for k, value := range row {
+			args[k] = value
+		}
+		if _, err = stmt.Exec(args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func init() {
+	RegisterSchema(ModelKitchenSink, Postgres, Schema{
+		Table: func() string { return types.KitchenSinkTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key,
+			amount numeric (14, 4) not null,
+			uuid uuid not null,
+			attributes jsonb not null,
+			payload bytea not null,
+			active boolean not null,
+			counter int8 not null,
+			tags text[] not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`TRUNCATE TABLE {TABLE}`},
+	})
+	// Sqlite has no numeric, uuid, json, or array types; they are stored as text in the form Postgres
+	// returns them.
+	RegisterSchema(ModelKitchenSink, Sqlite, Schema{
+		Table: func() string { return types.KitchenSinkTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key,
+			amount text not null,
+			uuid text not null,
+			attributes text not null,
+			payload blob not null,
+			active boolean not null,
+			counter integer not null,
+			tags text not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`DELETE FROM {TABLE}`},
+	})
+}
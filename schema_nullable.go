@@ -0,0 +1,125 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// NullableAddressQuery returns the query that selects limit rows from the nullable address table.
+func NullableAddressQuery(limit int) string {
+	return fmt.Sprintf(
+		"select %v from %v order by pk limit %v",
+		strings.Join(types.NullableAddressColumns, ", "), types.NullableAddressTableName, limit,
+	)
+}
+
+// NullRatios returns the NULL ratios the nullable benchmarks run with.  They are read from the
+// TEST_NULL_RATIOS environment variable as comma separated values from 0 to 1 and default to 0, 0.1, and 0.5.
+func NullRatios(b *testing.B) []float64 {
+	env := "TEST_NULL_RATIOS"
+	value := os.Getenv(env)
+	if value == "" {
+		return []float64{0, 0.1, 0.5}
+	}
+	rv := []float64{}
+	for _, field := range strings.Split(value, ",") {
+		ratio, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			b.Fatalf("%v has %q; expected a value from 0 to 1", env, field)
+		}
+		rv = append(rv, ratio)
+	}
+	return rv
+}
+
+// NullPercent returns ratio as a percent rounded to a tenth for benchmark names; formatting ratio*100
+// directly turns ratios such as 0.07 into 7.000000000000001.
+func NullPercent(ratio float64) string {
+	return strconv.FormatFloat(math.Round(ratio*1000)/10, 'g', -1, 64)
+}
+
+// SeedNullableAddresses inserts the rows of types.NullableAddressRows into the nullable address table.
+func SeedNullableAddresses(rows int, ratio float64, dialect Dialect, db *sql.DB) error {
+	var tx *sql.Tx
+	var stmt *sql.Stmt
+	var err error
+	//
+	params := make([]string, len(types.NullableAddressColumns))
+	for k := range params {
+		switch dialect {
+		case Postgres:
+			params[k] = fmt.Sprintf("$%v", k+1)
+		default:
+			params[k] = "?"
+		}
+	}
+	query := fmt.Sprintf(
+		"insert into %v ( %v ) values ( %v )",
+		types.NullableAddressTableName, strings.Join(types.NullableAddressColumns, ", "), strings.Join(params, ", "),
+	)
+	//
+	if tx, err = db.Begin(); err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if stmt, err = tx.Prepare(query); err != nil {
+		return err
+	}
+	defer stmt.Close()
+	args := make([]interface{}, len(params))
+	for _, row := range types.NullableAddressRows(rows, ratio) {
+		for k, value := range row {
+			args[k] = value
+			if t, ok := value.(time.Time); ok && dialect == Sqlite {
+				args[k] = t.UTC().Format(types.TimeTextLayout)
+			}
+		}
+		if _, err = stmt.Exec(args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func init() {
+	RegisterSchema(ModelNullableAddress, Postgres, Schema{
+		Table: func() string { return types.NullableAddressTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key,
+			created_tmz timestamp (6) with time zone,
+			modified_tmz timestamp (6) with time zone,
+			street character varying,
+			city character varying,
+			state character varying,
+			zip character varying
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`TRUNCATE TABLE {TABLE}`},
+	})
+	RegisterSchema(ModelNullableAddress, Sqlite, Schema{
+		Table: func() string { return types.NullableAddressTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key,
+			created_tmz datetime,
+			modified_tmz datetime,
+			street text,
+			city text,
+			state text,
+			zip text
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`DELETE FROM {TABLE}`},
+	})
+}
@@ -0,0 +1,257 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// SaleReportQuery returns the query that joins the sales tables into SaleReport rows.
+func SaleReportQuery(limit int) string {
+	query := `
+		select
+			s.pk, s.created_tmz, s.modified_tmz,
+			s.price, s.quantity, s.total,
+			c.pk as customer_id, c.first_name as customer_first, c.last_name as customer_last,
+			v.pk as vendor_id, v.name as vendor_name, v.description as vendor_description,
+			vc.pk as vendor_contact_id, vc.first_name as vendor_contact_first, vc.last_name as vendor_contact_last
+		from %v s
+		inner join %v c on c.pk = s.customer_fk
+		inner join %v v on v.pk = s.vendor_fk
+		inner join %v vc on vc.pk = s.vendor_contact_fk
+		order by s.pk
+		limit %v
+	`
+	return fmt.Sprintf(query, types.SaleTableName, types.CustomerTableName, types.VendorTableName, types.VendorContactTableName, limit)
+}
+
+// NestedSaleReportQuery returns SaleReportQuery with columns named for types.NestedSaleReport; the prefixes
+// of the embedded structs are joined by join.
+func NestedSaleReportQuery(limit int, join string) string {
+	query := `
+		select
+			s.pk, s.created_tmz, s.modified_tmz,
+			s.price, s.quantity, s.total,
+			c.pk as "%[6]v", c.first_name as "%[7]v", c.last_name as "%[8]v",
+			v.pk as "%[9]v", v.name as "%[10]v", v.description as "%[11]v",
+			vc.pk as "%[12]v", vc.first_name as "%[13]v", vc.last_name as "%[14]v"
+		from %[1]v s
+		inner join %[2]v c on c.pk = s.customer_fk
+		inner join %[3]v v on v.pk = s.vendor_fk
+		inner join %[4]v vc on vc.pk = s.vendor_contact_fk
+		order by s.pk
+		limit %[5]v
+	`
+	args := []interface{}{types.SaleTableName, types.CustomerTableName, types.VendorTableName, types.VendorContactTableName, limit}
+	for _, column := range types.NestedSaleReportColumns(join)[6:] {
+		args = append(args, column)
+	}
+	return fmt.Sprintf(query, args...)
+}
+
+// SeedSales inserts records into the sales tables; the customers, vendors, and vendor contacts are taken
+// from the records by id.
+func SeedSales(records []*types.SaleReport, dialect Dialect, db *sql.DB) error {
+	var tx *sql.Tx
+	var err error
+	//
+	if tx, err = db.Begin(); err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	//
+	customers, vendors, contacts := map[int]bool{}, map[int]bool{}, map[int]bool{}
+	inserts := []struct {
+		table   string
+		columns string
+		args    func(*types.SaleReport) []interface{}
+		skip    func(*types.SaleReport) bool
+	}{
+		{
+			types.CustomerTableName, "pk, first_name, last_name",
+			func(r *types.SaleReport) []interface{} {
+				return []interface{}{r.CustomerId, r.CustomerFirst, r.CustomerLast}
+			},
+			func(r *types.SaleReport) bool {
+				seen := customers[r.CustomerId]
+				customers[r.CustomerId] = true
+				return seen
+			},
+		},
+		{
+			types.VendorTableName, "pk, name, description",
+			func(r *types.SaleReport) []interface{} {
+				return []interface{}{r.VendorId, r.VendorName, r.VendorDescription}
+			},
+			func(r *types.SaleReport) bool {
+				seen := vendors[r.VendorId]
+				vendors[r.VendorId] = true
+				return seen
+			},
+		},
+		{
+			types.VendorContactTableName, "pk, vendor_fk, first_name, last_name",
+			func(r *types.SaleReport) []interface{} {
+				return []interface{}{r.VendorContactId, r.VendorId, r.VendorContactFirst, r.VendorContactLast}
+			},
+			func(r *types.SaleReport) bool {
+				seen := contacts[r.VendorContactId]
+				contacts[r.VendorContactId] = true
+				return seen
+			},
+		},
+		{
+			types.SaleTableName, "pk, created_tmz, modified_tmz, price, quantity, total, customer_fk, vendor_fk, vendor_contact_fk",
+			func(r *types.SaleReport) []interface{} {
+				return []interface{}{r.Id, r.CreatedTime, r.ModifiedTime, r.Price, r.Quantity, r.Total, r.CustomerId, r.VendorId, r.VendorContactId}
+			},
+			func(r *types.SaleReport) bool {
+				return false
+			},
+		},
+	}
+	for _, insert := range inserts {
+		n := strings.Count(insert.columns, ",") + 1
+		params := make([]string, n)
+		for k := range params {
+			switch dialect {
+			case Postgres:
+				params[k] = fmt.Sprintf("$%v", k+1)
+			default:
+				params[k] = "?"
+			}
+		}
+		query := fmt.Sprintf("insert into %v ( %v ) values ( %v )", insert.table, insert.columns, strings.Join(params, ", "))
+		//
+		var stmt *sql.Stmt
+		if stmt, err = tx.Prepare(query); err != nil {
+			return err
+		}
+		for _, record := range records {
+			if insert.skip(record) {
+				continue
+			} else if _, err = stmt.Exec(insert.args(record)...); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		stmt.Close()
+	}
+	return tx.Commit()
+}
+
+func init() {
+	RegisterSchema(ModelCustomer, Postgres, Schema{
+		Table: func() string { return types.CustomerTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk serial primary key,
+			first_name character varying not null,
+			last_name character varying not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`TRUNCATE TABLE {TABLE} RESTART IDENTITY CASCADE`},
+	})
+	RegisterSchema(ModelVendor, Postgres, Schema{
+		Table: func() string { return types.VendorTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk serial primary key,
+			name character varying not null,
+			description character varying not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`TRUNCATE TABLE {TABLE} RESTART IDENTITY CASCADE`},
+	})
+	RegisterSchema(ModelVendorContact, Postgres, Schema{
+		Table: func() string { return types.VendorContactTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk serial primary key,
+			vendor_fk integer not null references {TABLE:vendor} ( pk ),
+			first_name character varying not null,
+			last_name character varying not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`TRUNCATE TABLE {TABLE} RESTART IDENTITY CASCADE`},
+	})
+	RegisterSchema(ModelSale, Postgres, Schema{
+		Table: func() string { return types.SaleTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk serial primary key,
+			created_tmz date not null,
+			modified_tmz date not null,
+			price integer not null,
+			quantity integer not null,
+			total integer not null,
+			customer_fk integer not null references {TABLE:customer} ( pk ),
+			vendor_fk integer not null references {TABLE:vendor} ( pk ),
+			vendor_contact_fk integer not null references {TABLE:vendor_contact} ( pk )
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`TRUNCATE TABLE {TABLE} RESTART IDENTITY CASCADE`},
+	})
+	//
+	RegisterSchema(ModelCustomer, Sqlite, Schema{
+		Table: func() string { return types.CustomerTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key autoincrement,
+			first_name text not null,
+			last_name text not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`DELETE FROM {TABLE}`, `DELETE FROM sqlite_sequence WHERE name = '{TABLE}'`},
+	})
+	RegisterSchema(ModelVendor, Sqlite, Schema{
+		Table: func() string { return types.VendorTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key autoincrement,
+			name text not null,
+			description text not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`DELETE FROM {TABLE}`, `DELETE FROM sqlite_sequence WHERE name = '{TABLE}'`},
+	})
+	RegisterSchema(ModelVendorContact, Sqlite, Schema{
+		Table: func() string { return types.VendorContactTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key autoincrement,
+			vendor_fk integer not null references {TABLE:vendor} ( pk ),
+			first_name text not null,
+			last_name text not null
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`DELETE FROM {TABLE}`, `DELETE FROM sqlite_sequence WHERE name = '{TABLE}'`},
+	})
+	RegisterSchema(ModelSale, Sqlite, Schema{
+		Table: func() string { return types.SaleTableName },
+		Create: []string{
+			`CREATE TABLE {TABLE} (
+			pk integer primary key autoincrement,
+			created_tmz text not null,
+			modified_tmz text not null,
+			price integer not null,
+			quantity integer not null,
+			total integer not null,
+			customer_fk integer not null references {TABLE:customer} ( pk ),
+			vendor_fk integer not null references {TABLE:vendor} ( pk ),
+			vendor_contact_fk integer not null references {TABLE:vendor_contact} ( pk )
+		)`,
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: []string{`DELETE FROM {TABLE}`, `DELETE FROM sqlite_sequence WHERE name = '{TABLE}'`},
+	})
+}
@@ -0,0 +1,108 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/nofeaturesonlybugs/errors"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// WideModel returns the model name of the wide table with columns columns; see types.WideTypes.
+func WideModel(columns int) string {
+	return fmt.Sprintf("wide%v", columns)
+}
+
+// WideSelectQuery returns the query that selects limit rows from the wide table with columns columns.
+func WideSelectQuery(columns int, limit int) string {
+	return fmt.Sprintf(
+		"select %v from %v order by c000 limit %v",
+		strings.Join(types.WideColumns(columns), ", "), *types.WideTypes[columns].TableName, limit,
+	)
+}
+
+// SeedWide inserts rows rows of types.WideValues into the wide table with columns columns.
+func SeedWide(columns int, rows int, dialect Dialect, db *sql.DB) error {
+	var tx *sql.Tx
+	var stmt *sql.Stmt
+	var err error
+	//
+	wt, ok := types.WideTypes[columns]
+	if !ok {
+		return errors.Errorf("no wide type with %v columns", columns)
+	}
+	params := make([]string, columns)
+	for k := range params {
+		switch dialect {
+		case Postgres:
+			params[k] = fmt.Sprintf("$%v", k+1)
+		default:
+			params[k] = "?"
+		}
+	}
+	query := fmt.Sprintf(
+		"insert into %v ( %v ) values ( %v )",
+		*wt.TableName, strings.Join(types.WideColumns(columns), ", "), strings.Join(params, ", "),
+	)
+	//
+	if tx, err = db.Begin(); err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if stmt, err = tx.Prepare(query); err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for k := 0; k < rows; k++ {
+		if _, err = stmt.Exec(types.WideValues(columns, k)...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// wideSchema creates the Schema for the wide table with columns columns.
+func wideSchema(columns int, dialect Dialect) Schema {
+	sqlTypes := map[Dialect]map[reflect.Kind]string{
+		Postgres: {
+			reflect.Int:     "bigint",
+			reflect.String:  "character varying",
+			reflect.Float64: "double precision",
+			reflect.Bool:    "boolean",
+		},
+		Sqlite: {
+			reflect.Int:     "integer",
+			reflect.String:  "text",
+			reflect.Float64: "real",
+			reflect.Bool:    "boolean",
+		},
+	}[dialect]
+	definitions := make([]string, columns)
+	for k, column := range types.WideColumns(columns) {
+		definitions[k] = column + " " + sqlTypes[types.WideKind(k)] + " not null"
+	}
+	definitions[0] += " primary key"
+	//
+	truncate := []string{`TRUNCATE TABLE {TABLE}`}
+	if dialect == Sqlite {
+		truncate = []string{`DELETE FROM {TABLE}`}
+	}
+	return Schema{
+		Table: func() string { return *types.WideTypes[columns].TableName },
+		Create: []string{
+			"CREATE TABLE {TABLE} (\n\t" + strings.Join(definitions, ",\n\t") + "\n)",
+		},
+		Drop:     []string{`DROP TABLE IF EXISTS {TABLE}`},
+		Truncate: truncate,
+	}
+}
+
+func init() {
+	for columns := range types.WideTypes {
+		for _, dialect := range []Dialect{Postgres, Sqlite} {
+			RegisterSchema(WideModel(columns), dialect, wideSchema(columns, dialect))
+		}
+	}
+}
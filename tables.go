@@ -0,0 +1,145 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"os"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nofeaturesonlybugs/errors"
+	"github.com/nofeaturesonlybugs/sqlh/model"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+var (
+	tableSuffix     string
+	tableSuffixErr  error
+	tableSuffixOnce sync.Once
+	// tableSuffixValid restricts suffixes to characters that are safe in unquoted identifiers.
+	tableSuffixValid = regexp.MustCompile(`^[a-z0-9_]*$`)
+)
+
+// TableSuffix returns the suffix appended to every table name during this run of the benchmarks.
+//
+// The suffix comes from the TEST_TABLE_SUFFIX environment variable; when it is empty a suffix unique
+// to the process is generated so concurrent runs against the same database do not share tables.
+func TableSuffix() (string, error) {
+	tableSuffixOnce.Do(func() {
+		env := "TEST_TABLE_SUFFIX"
+		if tableSuffix = os.Getenv(env); tableSuffix == "" {
+			tableSuffix = "_" + strconv.FormatInt(time.Now().UnixNano(), 36) + "_" + strconv.Itoa(os.Getpid())
+		} else if !tableSuffixValid.MatchString(tableSuffix) {
+			tableSuffixErr = errors.Errorf("%v must contain only lowercase letters, digits, and underscores", env)
+		}
+	})
+	return tableSuffix, tableSuffixErr
+}
+
+// ResetTables truncates the tables for models and then calls seed, if it is non-nil, so that every library's
+// sub-benchmark starts from identical state.  It does not touch the timer of b; call it from the before
+// function of EachRun, which stops the timer, so it runs every time the benchmark framework calls the test.
+func ResetTables(b *testing.B, db *sql.DB, dialect Dialect, seed func() error, models ...string) {
+	if err := ExecSchema(db, dialect, StepTruncate, models...); err != nil {
+		b.Fatalf("resetting tables failed with %v", err.Error())
+	}
+	if seed != nil {
+		if err := seed(); err != nil {
+			b.Fatalf("seeding tables failed with %v", err.Error())
+		}
+	}
+}
+
+// EachRun creates a test that calls before every time the benchmark framework calls it, which is once per
+// b.N tried, and then runs the test before returns.  When before also returns an after function it is called
+// once the test finishes; a non-nil error from after fails b.  before and after run with the timer stopped.
+//
+// Benchmarks use it to reset tables and begin transactions so every b.N starts from identical state.
+func EachRun(before func(b *testing.B) (test func(*testing.B), after func() error)) func(*testing.B) {
+	fn := func(b *testing.B) {
+		b.StopTimer()
+		test, after := before(b)
+		b.StartTimer()
+		//
+		test(b)
+		//
+		b.StopTimer()
+		if after != nil {
+			if err := after(); err != nil {
+				b.Fatalf("after test failed with %v", err.Error())
+			}
+		}
+	}
+	return fn
+}
+
+// WithReset creates a test that calls reset with the timer stopped every time the benchmark framework calls
+// it and then runs test.
+func WithReset(reset func(*testing.B), test func(*testing.B)) func(*testing.B) {
+	return EachRun(func(b *testing.B) (func(*testing.B), func() error) {
+		reset(b)
+		return test, nil
+	})
+}
+
+// UpdateSeed returns the seed of the update benchmarks.  It inserts addresses with the values they had when
+// UpdateSeed was called and then modifies them in place, doubling their strings and moving their modified
+// time back an hour, so every update writes values that differ from the stored rows.
+func UpdateSeed(mdb *model.Models, addresses []*types.Address, db *sql.DB) func() error {
+	originals := make([]types.Address, len(addresses))
+	for k, address := range addresses {
+		originals[k] = *address
+	}
+	return func() error {
+		for k, address := range addresses {
+			*address = originals[k]
+		}
+		if err := mdb.Insert(db, addresses); err != nil {
+			return err
+		}
+		// Now modify every address.
+		for _, address := range addresses {
+			address.Street = address.Street + address.Street
+			address.City = address.City + address.City
+			address.State = address.State + address.State
+			address.Zip = address.Zip + address.Zip
+			address.ModifiedTime.Time = address.ModifiedTime.Time.Add(-1 * time.Hour) // Just to make sure modified time updates
+		}
+		return nil
+	}
+}
+
+// SeedTables calls seed once and fails b if it returns an error; the select benchmarks use it for tables no
+// library changes.
+func SeedTables(b *testing.B, seed func() error) {
+	if err := seed(); err != nil {
+		b.Fatalf("seeding database with %v", err.Error())
+	}
+}
+
+// Contender is the sub-benchmark of one library.
+type Contender struct {
+	Name string
+	Test func(*testing.B)
+}
+
+// Contenders are the libraries compared for one row count or configuration.
+type Contenders []Contender
+
+// RunContenders runs each contender as a sub-benchmark of b named for the contender followed by suffix, if
+// any; order decides the order they run in.
+func RunContenders(b *testing.B, order *Order, suffix string, contenders Contenders) {
+	runs := make([]func(), len(contenders))
+	for k, contender := range contenders {
+		name, test := contender.Name, contender.Test
+		if suffix != "" {
+			name += " " + suffix
+		}
+		runs[k] = func() {
+			b.Run(name, test)
+		}
+	}
+	order.Run(runs...)
+}
@@ -0,0 +1,100 @@
+package sqlhbenchmarks
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/nofeaturesonlybugs/sqlh"
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+// TxSavepoint is the name of the savepoint taken around each row by TxMode.Savepoint.
+const TxSavepoint = "sqlh_row"
+
+// TxMode describes how the transaction benchmarks wrap a run of single-row INSERTs.
+//
+//	Batch == 0, !Savepoint	every row is autocommitted
+//	Batch > 0, !Savepoint	a transaction is committed after every Batch rows
+//	Savepoint		one transaction for all rows with a savepoint released after each row
+type TxMode struct {
+	Name      string
+	Batch     int
+	Savepoint bool
+}
+
+// TxModes are the transaction modes compared by the transaction benchmarks.
+var TxModes = []TxMode{
+	{Name: "autocommit"},
+	{Name: "tx per row", Batch: 1},
+	{Name: "tx per 100 rows", Batch: 100},
+	{Name: "savepoint per row", Savepoint: true},
+}
+
+// TxInserter inserts one address with q, which is the *sql.DB when autocommitting or the *sql.Tx of the
+// current transaction.
+type TxInserter func(q sqlh.IQueries, address *types.Address) error
+
+// TxInsert creates a test that inserts addresses one at a time with insert while managing transactions as
+// described by mode.
+func TxInsert(mode TxMode, insert TxInserter, addresses []*types.Address, db *sql.DB) func(*testing.B) {
+	fn := func(b *testing.B) {
+		var tx *sql.Tx
+		var err error
+		//
+		begin := func() {
+			if tx, err = db.Begin(); err != nil {
+				b.Fatalf("error beginning transaction with %v", err.Error())
+			}
+		}
+		commit := func() {
+			if err = tx.Commit(); err != nil {
+				b.Fatalf("error durring commit with %v", err.Error())
+			}
+			tx = nil
+		}
+		//
+		for k := 0; k < b.N; k++ {
+			if mode.Savepoint {
+				begin()
+			}
+			for n, address := range addresses {
+				address.PreInsert(b)
+				//
+				switch {
+				case mode.Savepoint:
+					if _, err = tx.Exec("savepoint " + TxSavepoint); err != nil {
+						b.Fatalf("savepoint failed with %v", err.Error())
+					}
+					if err = insert(tx, address); err != nil {
+						b.Fatalf("%v failed with %v", mode.Name, err.Error())
+					}
+					if _, err = tx.Exec("release savepoint " + TxSavepoint); err != nil {
+						b.Fatalf("release savepoint failed with %v", err.Error())
+					}
+
+				case mode.Batch > 0:
+					if tx == nil {
+						begin()
+					}
+					if err = insert(tx, address); err != nil {
+						b.Fatalf("%v failed with %v", mode.Name, err.Error())
+					}
+					if (n+1)%mode.Batch == 0 {
+						commit()
+					}
+
+				default:
+					if err = insert(db, address); err != nil {
+						b.Fatalf("%v failed with %v", mode.Name, err.Error())
+					}
+				}
+				//
+				address.PostInsert(b)
+			}
+			if tx != nil {
+				commit()
+			}
+		}
+	}
+	return fn
+}
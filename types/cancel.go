@@ -0,0 +1,50 @@
+package types
+
+// cancelTrigger is the state shared by every CancelTrigger; benchmarks run one at a time so a single
+// trigger is enough.
+var cancelTrigger struct {
+	rows    int
+	scanned int
+	fn      func()
+}
+
+// SetCancelTrigger arranges for fn to be called when the rows-th CancelTrigger is scanned and resets the
+// count of scanned values.  A nil fn disarms the trigger.
+func SetCancelTrigger(rows int, fn func()) {
+	cancelTrigger.rows, cancelTrigger.scanned, cancelTrigger.fn = rows, 0, fn
+}
+
+// CancelTrigger is a column destination that calls the function given to SetCancelTrigger from inside a
+// library's scan loop; it lets the cancellation benchmarks cancel after an exact number of rows.
+type CancelTrigger struct{}
+
+// Scan implements the Scanner interface.
+func (me *CancelTrigger) Scan(value interface{}) error {
+	if cancelTrigger.scanned++; cancelTrigger.scanned == cancelTrigger.rows && cancelTrigger.fn != nil {
+		cancelTrigger.fn()
+	}
+	return nil
+}
+
+// CancelAddress is Address with a CancelTrigger scanned from the cancel_trigger column.
+type CancelAddress struct {
+	Id           int           `json:"id" db:"pk" gorm:"column:pk"`
+	CreatedTime  Time          `json:"created_time" db:"created_tmz" gorm:"column:created_tmz"`
+	ModifiedTime Time          `json:"modified_time" db:"modified_tmz" gorm:"column:modified_tmz"`
+	Street       string        `json:"street"`
+	City         string        `json:"city"`
+	State        string        `json:"state"`
+	Zip          string        `json:"zip"`
+	Trigger      CancelTrigger `json:"cancel_trigger" db:"cancel_trigger" gorm:"column:cancel_trigger"`
+}
+
+// CancelAddressColumns are the columns scanned into CancelAddress.
+var CancelAddressColumns = []string{
+	"pk", "created_tmz", "modified_tmz", "street", "city", "state", "zip", "cancel_trigger",
+}
+
+func (me *CancelAddress) Pointers() []interface{} {
+	return []interface{}{
+		&me.Id, &me.CreatedTime, &me.ModifiedTime, &me.Street, &me.City, &me.State, &me.Zip, &me.Trigger,
+	}
+}
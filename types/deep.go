@@ -0,0 +1,30 @@
+package types
+
+import (
+	"strings"
+)
+
+// DeepAddress nests Address three structs deep so each column name is the prefixes record, location, and
+// address joined to the name of an Address column.
+type DeepAddress struct {
+	Record DeepRecord `json:"record" db:"record"`
+}
+
+// DeepRecord is the first level of DeepAddress.
+type DeepRecord struct {
+	Location DeepLocation `json:"location" db:"location"`
+}
+
+// DeepLocation is the second level of DeepAddress.
+type DeepLocation struct {
+	Address Address `json:"address" db:"address"`
+}
+
+// DeepAddressColumns returns the column names of a DeepAddress with the prefixes joined by join.
+func DeepAddressColumns(join string) []string {
+	prefix := strings.Join([]string{"record", "location", "address", ""}, join)
+	return []string{
+		prefix + "pk", prefix + "created_tmz", prefix + "modified_tmz",
+		prefix + "street", prefix + "city", prefix + "state", prefix + "zip",
+	}
+}
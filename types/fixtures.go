@@ -0,0 +1,172 @@
+package types
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+
+	"github.com/nofeaturesonlybugs/errors"
+	"github.com/nofeaturesonlybugs/set"
+)
+
+// Fixture formats are selected by file extension.
+const (
+	FixtureJSON   = ".json"
+	FixtureNDJSON = ".ndjson"
+	FixtureCSV    = ".csv"
+)
+
+// Fixture file names without their extension.
+const (
+	AddressFixture = "addresses"
+	SaleFixture    = "sales"
+)
+
+// fixtureMapper maps CSV header names to fields; headers use the same names as JSON fixtures.
+var fixtureMapper = &set.Mapper{
+	TreatAsScalar: set.NewTypeList(Time{}),
+	Join:          "_",
+	Tags:          []string{"json"},
+}
+
+// LoadFixtureDir calls LoadFixtures for the directory dir.
+func LoadFixtureDir(dir string) error {
+	if info, err := os.Stat(dir); err != nil {
+		return err
+	} else if !info.IsDir() {
+		return errors.Errorf("%v is not a directory", dir)
+	}
+	return LoadFixtures(os.DirFS(dir))
+}
+
+// LoadFixtures replaces the records returned by AddressRecords and SaleRecords with the records found in
+// fsys.  Each dataset is read from the file named by AddressFixture or SaleFixture followed by one of the
+// fixture extensions; a dataset without a file, or whose files are empty, keeps its current records.
+func LoadFixtures(fsys fs.FS) error {
+	var addresses []*Address
+	var sales []*SaleReport
+	for _, ext := range []string{FixtureJSON, FixtureNDJSON, FixtureCSV} {
+		if err := ReadFixture(fsys, AddressFixture+ext, &addresses); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err := ReadFixture(fsys, SaleFixture+ext, &sales); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	records.Lock()
+	defer records.Unlock()
+	if len(addresses) > 0 {
+		records.addresses = addresses
+	}
+	if len(sales) > 0 {
+		records.sales = sales
+	}
+	return nil
+}
+
+// ReadFixture appends the records in the file name from fsys to dest, which must be the address of a
+// slice of struct pointers.  The format is chosen by the extension of name:
+//
+//	.json	a JSON array of objects
+//	.ndjson	one JSON object per line
+//	.csv	a header row of JSON names followed by one record per row
+//
+// An empty file has no records in every format.
+func ReadFixture(fsys fs.FS, name string, dest interface{}) error {
+	V := reflect.ValueOf(dest)
+	if V.Kind() != reflect.Ptr || V.Elem().Kind() != reflect.Slice || V.Elem().Type().Elem().Kind() != reflect.Ptr {
+		return errors.Errorf("%T is not the address of a slice of pointers", dest)
+	}
+	file, err := fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	//
+	switch ext := path.Ext(name); ext {
+	case FixtureJSON:
+		records := reflect.New(V.Elem().Type())
+		if err = json.NewDecoder(file).Decode(records.Interface()); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("%v: %v", name, err.Error())
+		}
+		V.Elem().Set(reflect.AppendSlice(V.Elem(), records.Elem()))
+
+	case FixtureNDJSON:
+		err = readNDJSON(file, V.Elem())
+
+	case FixtureCSV:
+		err = readCSV(file, V.Elem())
+
+	default:
+		return errors.Errorf("%v: unsupported fixture format %v", name, ext)
+	}
+	if err != nil {
+		return errors.Errorf("%v: %v", name, err.Error())
+	}
+	return nil
+}
+
+// readNDJSON appends one record per non-empty line in r to slice.
+func readNDJSON(r io.Reader, slice reflect.Value) error {
+	T := slice.Type().Elem().Elem()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := reflect.New(T)
+		if err := json.Unmarshal(scanner.Bytes(), record.Interface()); err != nil {
+			return errors.Errorf("line %v: %v", line, err.Error())
+		}
+		slice.Set(reflect.Append(slice, record))
+	}
+	return scanner.Err()
+}
+
+// readCSV appends one record per row in r to slice.  Values are assigned with the Scan method of fields
+// implementing sql.Scanner and coerced by package set otherwise.
+func readCSV(r io.Reader, slice reflect.Value) error {
+	T := slice.Type().Elem().Elem()
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	header = append([]string{}, header...)
+	assignables := make([]interface{}, len(header))
+	for line := 2; ; line++ {
+		values, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		record := reflect.New(T)
+		if _, err = fixtureMapper.Bind(record.Interface()).Assignables(header, assignables); err != nil {
+			return err
+		}
+		for k, assignable := range assignables {
+			if scanner, ok := assignable.(sql.Scanner); ok {
+				err = scanner.Scan(values[k])
+			} else {
+				err = set.V(assignable).To(values[k])
+			}
+			if err != nil {
+				return errors.Errorf("line %v column %v: %v", line, header[k], err.Error())
+			}
+		}
+		slice.Set(reflect.Append(slice, record))
+	}
+}
@@ -0,0 +1,158 @@
+package types_test
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+func TestReadFixture(t *testing.T) {
+	houston := &types.Address{Street: "47041 2nd Park", City: "Houston", State: "Texas", Zip: "77223"}
+	sacramento := &types.Address{Street: "5 Hooker Center", City: "Sacramento", State: "California", Zip: "95852"}
+	tests := []struct {
+		Name    string
+		File    string
+		Content string
+		Expect  []*types.Address
+		Error   bool
+	}{
+		{
+			Name:    "json",
+			File:    "addresses.json",
+			Content: `[{"street":"47041 2nd Park","city":"Houston","state":"Texas","zip":"77223"},{"street":"5 Hooker Center","city":"Sacramento","state":"California","zip":"95852"}]`,
+			Expect:  []*types.Address{houston, sacramento},
+		},
+		{
+			Name:    "json malformed",
+			File:    "addresses.json",
+			Content: `[{"street":"47041 2nd Park","city":"Houston"},{"street":`,
+			Error:   true,
+		},
+		{
+			Name:    "json wrong type",
+			File:    "addresses.json",
+			Content: `[{"street":47041}]`,
+			Error:   true,
+		},
+		{
+			Name: "json empty",
+			File: "addresses.json",
+		},
+		{
+			Name: "ndjson",
+			File: "addresses.ndjson",
+			Content: `{"street":"47041 2nd Park","city":"Houston","state":"Texas","zip":"77223"}
+
+{"street":"5 Hooker Center","city":"Sacramento","state":"California","zip":"95852"}
+`,
+			Expect: []*types.Address{houston, sacramento},
+		},
+		{
+			Name: "ndjson malformed row",
+			File: "addresses.ndjson",
+			Content: `{"street":"47041 2nd Park","city":"Houston","state":"Texas","zip":"77223"}
+{"street":"5 Hooker Center",
+`,
+			Error: true,
+		},
+		{
+			Name: "ndjson empty",
+			File: "addresses.ndjson",
+		},
+		{
+			Name: "csv",
+			File: "addresses.csv",
+			Content: `street,city,state,zip
+47041 2nd Park,Houston,Texas,77223
+5 Hooker Center,Sacramento,California,95852
+`,
+			Expect: []*types.Address{houston, sacramento},
+		},
+		{
+			Name: "csv short row",
+			File: "addresses.csv",
+			Content: `street,city,state,zip
+47041 2nd Park,Houston,Texas
+`,
+			Error: true,
+		},
+		{
+			Name: "csv bad value",
+			File: "addresses.csv",
+			Content: `id,street
+x,47041 2nd Park
+`,
+			Error: true,
+		},
+		{
+			Name: "csv unknown column",
+			File: "addresses.csv",
+			Content: `street,unknown
+47041 2nd Park,x
+`,
+			Error: true,
+		},
+		{
+			Name: "csv empty",
+			File: "addresses.csv",
+		},
+		{
+			Name:    "unknown extension",
+			File:    "addresses.xml",
+			Content: `<addresses/>`,
+			Error:   true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			fsys := fstest.MapFS{test.File: &fstest.MapFile{Data: []byte(test.Content)}}
+			var dest []*types.Address
+			err := types.ReadFixture(fsys, test.File, &dest)
+			if test.Error {
+				if err == nil {
+					t.Fatalf("expected an error; read %v records", len(dest))
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("read failed with %v", err.Error())
+			}
+			if len(dest) != len(test.Expect) {
+				t.Fatalf("read %v records; expected %v", len(dest), len(test.Expect))
+			}
+			for k, expect := range test.Expect {
+				if !reflect.DeepEqual(dest[k], expect) {
+					t.Errorf("record %v is %+v; expected %+v", k, dest[k], expect)
+				}
+			}
+		})
+	}
+}
+
+func TestReadFixtureDest(t *testing.T) {
+	fsys := fstest.MapFS{"addresses.json": &fstest.MapFile{Data: []byte(`[]`)}}
+	for _, dest := range []interface{}{nil, []*types.Address{}, &[]types.Address{}, &types.Address{}} {
+		if err := types.ReadFixture(fsys, "addresses.json", dest); err == nil {
+			t.Errorf("%T: expected an error", dest)
+		}
+	}
+	if err := types.ReadFixture(fsys, "missing.json", &[]*types.Address{}); err == nil {
+		t.Errorf("missing file: expected an error")
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	before := types.AddressRecords()
+	//
+	// A directory without fixtures and a malformed fixture both keep the current records.
+	if err := types.LoadFixtures(fstest.MapFS{"other.json": &fstest.MapFile{Data: []byte(`[]`)}}); err != nil {
+		t.Fatalf("loading without fixtures failed with %v", err.Error())
+	}
+	if err := types.LoadFixtures(fstest.MapFS{"addresses.ndjson": &fstest.MapFile{Data: []byte(`{`)}}); err == nil {
+		t.Fatalf("loading a malformed fixture: expected an error")
+	}
+	if after := types.AddressRecords(); len(after) != len(before) || after[0] != before[0] {
+		t.Fatalf("records changed from %v to %v", len(before), len(after))
+	}
+}
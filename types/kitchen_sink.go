@@ -0,0 +1,76 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/rand"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+)
+
+// KitchenSink is a SELECT destination with one member for each of the column types numeric, uuid, jsonb,
+// bytea, boolean, int8, and text[].
+type KitchenSink struct {
+	Id         int            `json:"id" db:"pk" gorm:"column:pk"`
+	Amount     Decimal        `json:"amount" db:"amount"`
+	Uuid       UUID           `json:"uuid" db:"uuid"`
+	Attributes JSON           `json:"attributes" db:"attributes"`
+	Payload    []byte         `json:"payload" db:"payload"`
+	Active     bool           `json:"active" db:"active"`
+	Counter    int64          `json:"counter" db:"counter"`
+	Tags       pq.StringArray `json:"tags" db:"tags" gorm:"type:text[]"`
+}
+
+// KitchenSinkColumns are the columns of the kitchen sink table.
+var KitchenSinkColumns = []string{
+	"pk", "amount", "uuid", "attributes", "payload", "active", "counter", "tags",
+}
+
+func (me *KitchenSink) Pointers() []interface{} {
+	return []interface{}{
+		&me.Id, &me.Amount, &me.Uuid, &me.Attributes, &me.Payload, &me.Active, &me.Counter, &me.Tags,
+	}
+}
+
+// KitchenSinkRows returns n rows of KitchenSinkColumns.  The values are drawn from a fixed seed so calls with
+// equal arguments return equal rows; each value is in the form a driver would send it.
+func KitchenSinkRows(n int) [][]driver.Value {
+	colors := []string{"red", "green", "blue", "black", "white"}
+	words := []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot", "golf", "hotel"}
+	rng := rand.New(rand.NewSource(1))
+	rv := make([][]driver.Value, n)
+	for k := range rv {
+		var uuid UUID
+		rng.Read(uuid[:])
+		payload := make([]byte, 16+rng.Intn(48))
+		rng.Read(payload)
+		tags := pq.StringArray{}
+		for t := rng.Intn(4); t >= 0; t-- {
+			tags = append(tags, words[rng.Intn(len(words))])
+		}
+		attributes := JSON{
+			"color":  colors[rng.Intn(len(colors))],
+			"size":   rng.Intn(100),
+			"weight": fmt.Sprintf("%.2f", rng.Float64()*100),
+		}
+		//
+		amount, _ := Decimal{Unscaled: rng.Int63n(1000000000) - 500000000, Scale: 4}.Value()
+		uuidValue, _ := uuid.Value()
+		attributesValue, _ := attributes.Value()
+		tagsValue, _ := tags.Value()
+		rv[k] = []driver.Value{
+			k + 1, amount, uuidValue, attributesValue, payload, rng.Intn(2) == 1, rng.Int63(), tagsValue,
+		}
+	}
+	return rv
+}
+
+// KitchenSinkMockRows returns the rows of KitchenSinkRows as mocked rows.
+func KitchenSinkMockRows(n int) *sqlmock.Rows {
+	rows := sqlmock.NewRows(KitchenSinkColumns)
+	for _, row := range KitchenSinkRows(n) {
+		rows.AddRow(row...)
+	}
+	return rows
+}
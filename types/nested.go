@@ -0,0 +1,92 @@
+package types
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// Customer is the customer portion of a nested sale report.
+type Customer struct {
+	Id    int    `json:"id" db:"id"`
+	First string `json:"first" db:"first"`
+	Last  string `json:"last" db:"last"`
+}
+
+// Vendor is the vendor portion of a nested sale report.
+type Vendor struct {
+	Id          int    `json:"id" db:"id"`
+	Name        string `json:"name" db:"name"`
+	Description string `json:"description" db:"description"`
+}
+
+// VendorContact is the vendor contact portion of a nested sale report.
+type VendorContact struct {
+	Id    int    `json:"id" db:"id"`
+	First string `json:"first" db:"first"`
+	Last  string `json:"last" db:"last"`
+}
+
+// NestedSaleReport is SaleReport split into embedded structs.  Each embedded struct is tagged so its
+// columns are prefixed: customer_id for sqlh and customer.id for sqlx and scany.
+type NestedSaleReport struct {
+	Id            int    `json:"id" db:"pk"`
+	CreatedTime   string `json:"created_time" db:"created_tmz"`
+	ModifiedTime  string `json:"modified_time" db:"modified_tmz"`
+	Price         int    `json:"price" db:"price"`
+	Quantity      int    `json:"quantity" db:"quantity"`
+	Total         int    `json:"total" db:"total"`
+	Customer      `json:"customer" db:"customer"`
+	Vendor        `json:"vendor" db:"vendor"`
+	VendorContact `json:"vendor_contact" db:"vendor_contact"`
+}
+
+// NestedSaleReportPtr is NestedSaleReport with pointers to the embedded structs; scanning must
+// instantiate them.
+type NestedSaleReportPtr struct {
+	Id             int    `json:"id" db:"pk"`
+	CreatedTime    string `json:"created_time" db:"created_tmz"`
+	ModifiedTime   string `json:"modified_time" db:"modified_tmz"`
+	Price          int    `json:"price" db:"price"`
+	Quantity       int    `json:"quantity" db:"quantity"`
+	Total          int    `json:"total" db:"total"`
+	*Customer      `json:"customer" db:"customer"`
+	*Vendor        `json:"vendor" db:"vendor"`
+	*VendorContact `json:"vendor_contact" db:"vendor_contact"`
+}
+
+// NewNestedSaleReports returns the address of a nil []*NestedSaleReport or, when pointers is true, a
+// nil []*NestedSaleReportPtr.
+func NewNestedSaleReports(pointers bool) interface{} {
+	if pointers {
+		return &[]*NestedSaleReportPtr{}
+	}
+	return &[]*NestedSaleReport{}
+}
+
+// NestedSaleReportColumns returns the column names of a nested sale report with the embedded struct
+// prefixes joined by join.
+func NestedSaleReportColumns(join string) []string {
+	return []string{
+		"pk", "created_tmz", "modified_tmz",
+		"price", "quantity", "total",
+		"customer" + join + "id", "customer" + join + "first", "customer" + join + "last",
+		"vendor" + join + "id", "vendor" + join + "name", "vendor" + join + "description",
+		"vendor_contact" + join + "id", "vendor_contact" + join + "first", "vendor_contact" + join + "last",
+	}
+}
+
+// NestedSaleReportMockRows returns n rows of SaleRecords() named by NestedSaleReportColumns(join).
+func NestedSaleReportMockRows(n int, join string) *sqlmock.Rows {
+	rows := sqlmock.NewRows(NestedSaleReportColumns(join))
+	sales := SaleRecords()
+	for k := 0; k < n; k++ {
+		j := sales[k%len(sales)]
+		rows.AddRow(
+			j.Id, j.CreatedTime, j.ModifiedTime,
+			j.Price, j.Quantity, j.Total,
+			j.CustomerId, j.CustomerFirst, j.CustomerLast,
+			j.VendorId, j.VendorName, j.VendorDescription,
+			j.VendorContactId, j.VendorContactFirst, j.VendorContactLast,
+		)
+	}
+	return rows
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"math/rand"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// NullableAddress is implemented by the nullable address destinations.
+type NullableAddress interface {
+	// Pointers returns the addresses of the members in column order.
+	Pointers() []interface{}
+}
+
+// NullableAddressPtr is a SELECT destination for the nullable address table using pointers for nullable
+// strings and NullTime for nullable times.
+type NullableAddressPtr struct {
+	Id           int      `json:"id" db:"pk" gorm:"column:pk"`
+	CreatedTime  NullTime `json:"created_time" db:"created_tmz" gorm:"column:created_tmz"`
+	ModifiedTime NullTime `json:"modified_time" db:"modified_tmz" gorm:"column:modified_tmz"`
+	Street       *string  `json:"street"`
+	City         *string  `json:"city"`
+	State        *string  `json:"state"`
+	Zip          *string  `json:"zip"`
+}
+
+func (me *NullableAddressPtr) Pointers() []interface{} {
+	return []interface{}{
+		&me.Id, &me.CreatedTime, &me.ModifiedTime,
+		&me.Street, &me.City, &me.State, &me.Zip,
+	}
+}
+
+// NullableAddressSql is a SELECT destination for the nullable address table using the sql.Null* types.
+type NullableAddressSql struct {
+	Id           int            `json:"id" db:"pk" gorm:"column:pk"`
+	CreatedTime  sql.NullTime   `json:"created_time" db:"created_tmz" gorm:"column:created_tmz"`
+	ModifiedTime sql.NullTime   `json:"modified_time" db:"modified_tmz" gorm:"column:modified_tmz"`
+	Street       sql.NullString `json:"street"`
+	City         sql.NullString `json:"city"`
+	State        sql.NullString `json:"state"`
+	Zip          sql.NullString `json:"zip"`
+}
+
+func (me *NullableAddressSql) Pointers() []interface{} {
+	return []interface{}{
+		&me.Id, &me.CreatedTime, &me.ModifiedTime,
+		&me.Street, &me.City, &me.State, &me.Zip,
+	}
+}
+
+// Nullable destination kinds; see NewNullableAddresses.
+const (
+	NullablePtr = "pointers"
+	NullableSql = "sql.Null"
+)
+
+// NewNullableAddress returns a new NullableAddressPtr or NullableAddressSql for the destination kind.
+func NewNullableAddress(kind string) NullableAddress {
+	if kind == NullableSql {
+		return &NullableAddressSql{}
+	}
+	return &NullableAddressPtr{}
+}
+
+// NewNullableAddresses returns the address of a nil []*NullableAddressPtr or []*NullableAddressSql for the
+// destination kind.
+func NewNullableAddresses(kind string) interface{} {
+	if kind == NullableSql {
+		return &[]*NullableAddressSql{}
+	}
+	return &[]*NullableAddressPtr{}
+}
+
+// NullableAddressColumns are the columns of the nullable address table.
+var NullableAddressColumns = []string{
+	"pk", "created_tmz", "modified_tmz",
+	"street", "city", "state", "zip",
+}
+
+// NullableAddressRows returns n rows of NullableAddressColumns taken from AddressRecords().  Every column
+// except pk is nil with probability ratio; the nils are drawn from a fixed seed so calls with equal
+// arguments return equal rows.
+func NullableAddressRows(n int, ratio float64) [][]driver.Value {
+	rng := rand.New(rand.NewSource(1))
+	epoch := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	addresses := AddressRecords()
+	rv := make([][]driver.Value, n)
+	for k := range rv {
+		j := addresses[k%len(addresses)]
+		created := epoch.Add(time.Duration(k) * time.Minute)
+		rv[k] = []driver.Value{
+			k + 1, created, created.Add(time.Hour),
+			j.Street, j.City, j.State, j.Zip,
+		}
+		for c := 1; c < len(rv[k]); c++ {
+			if rng.Float64() < ratio {
+				rv[k][c] = nil
+			}
+		}
+	}
+	return rv
+}
+
+// NullableAddressMockRows returns the rows of NullableAddressRows as mocked rows.
+func NullableAddressMockRows(n int, ratio float64) *sqlmock.Rows {
+	rows := sqlmock.NewRows(NullableAddressColumns)
+	for _, row := range NullableAddressRows(n, ratio) {
+		rows.AddRow(row...)
+	}
+	return rows
+}
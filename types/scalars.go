@@ -0,0 +1,153 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/nofeaturesonlybugs/errors"
+)
+
+// Decimal is a fixed point number equal to Unscaled * 10^-Scale; it scans numeric columns without the
+// rounding of float64.
+type Decimal struct {
+	Unscaled int64
+	Scale    int
+}
+
+// ParseDecimal parses a decimal string such as -123.4500.
+func ParseDecimal(s string) (Decimal, error) {
+	digits, scale := s, 0
+	if n := strings.IndexByte(s, '.'); n != -1 {
+		digits, scale = s[0:n]+s[n+1:], len(s)-n-1
+	}
+	unscaled, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Decimal{}, errors.Errorf("%q is not a decimal", s)
+	}
+	return Decimal{Unscaled: unscaled, Scale: scale}, nil
+}
+
+// String returns the decimal with Scale digits after the decimal point.
+func (me Decimal) String() string {
+	sign, digits := "", strconv.FormatInt(me.Unscaled, 10)
+	if me.Unscaled < 0 {
+		sign, digits = "-", digits[1:]
+	}
+	if me.Scale <= 0 {
+		return sign + digits
+	}
+	if pad := me.Scale + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	return sign + digits[0:len(digits)-me.Scale] + "." + digits[len(digits)-me.Scale:]
+}
+
+// Scan implements the Scanner interface.
+func (me *Decimal) Scan(value interface{}) error {
+	var err error
+	switch v := value.(type) {
+	case []byte:
+		*me, err = ParseDecimal(string(v))
+
+	case string:
+		*me, err = ParseDecimal(v)
+
+	case int64:
+		*me = Decimal{Unscaled: v}
+
+	case float64:
+		*me, err = ParseDecimal(strconv.FormatFloat(v, 'f', -1, 64))
+
+	default:
+		err = errors.Errorf("%T unsupported for Decimal", value)
+	}
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (me Decimal) Value() (driver.Value, error) {
+	return me.String(), nil
+}
+
+// UUID is a 16 byte universally unique identifier.
+type UUID [16]byte
+
+// ParseUUID parses the 36 character form of a UUID such as 01234567-89ab-cdef-0123-456789abcdef.
+func ParseUUID(s string) (UUID, error) {
+	var rv UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return rv, errors.Errorf("%q is not a uuid", s)
+	}
+	src := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err := hex.Decode(rv[:], []byte(src)); err != nil {
+		return rv, errors.Errorf("%q is not a uuid", s)
+	}
+	return rv, nil
+}
+
+// String returns the 36 character form of the UUID.
+func (me UUID) String() string {
+	s := hex.EncodeToString(me[:])
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+// Scan implements the Scanner interface; 16 byte values are copied as raw bytes.
+func (me *UUID) Scan(value interface{}) error {
+	var err error
+	switch v := value.(type) {
+	case []byte:
+		if len(v) == len(me) {
+			copy(me[:], v)
+		} else {
+			*me, err = ParseUUID(string(v))
+		}
+
+	case string:
+		*me, err = ParseUUID(v)
+
+	default:
+		err = errors.Errorf("%T unsupported for UUID", value)
+	}
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (me UUID) Value() (driver.Value, error) {
+	return me.String(), nil
+}
+
+// JSON is a JSON object stored in a json, jsonb, or text column.
+type JSON map[string]interface{}
+
+// Scan implements the Scanner interface.
+func (me *JSON) Scan(value interface{}) error {
+	*me = nil
+	switch v := value.(type) {
+	case nil:
+		return nil
+
+	case []byte:
+		return json.Unmarshal(v, me)
+
+	case string:
+		return json.Unmarshal([]byte(v), me)
+
+	default:
+		return errors.Errorf("%T unsupported for JSON", value)
+	}
+}
+
+// Value implements the driver Valuer interface.
+func (me JSON) Value() (driver.Value, error) {
+	if me == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(me)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
@@ -9,12 +9,62 @@ import (
 
 var ZeroTime Time
 
+// TimeEncoding selects the driver.Value returned by Time.Value; see SetTimeEncoding.
+type TimeEncoding int
+
+const (
+	// TimeNative passes time.Time to the driver; use it with drivers that encode time.Time themselves,
+	// such as lib/pq.
+	TimeNative TimeEncoding = iota
+	// TimeText formats the time in UTC with TimeTextLayout; use it with text columns such as Sqlite
+	// datetime.
+	TimeText
+	// TimeUnix writes whole Unix seconds; sub-second precision is lost.
+	TimeUnix
+	// TimeUnixNano writes Unix nanoseconds; Scan reads int64 values as nanoseconds as well.
+	TimeUnixNano
+)
+
+// TimeTextLayout is the layout written by TimeText; values sort as text and are understood by the Sqlite
+// date functions.
+const TimeTextLayout = "2006-01-02 15:04:05.000000000"
+
+// timeLayouts are the layouts Scan tries in order for string and []byte values.  Layouts without a zone
+// are read as UTC and fractional seconds are optional in all of them.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// timeEncoding is the current TimeEncoding.
+var timeEncoding = TimeNative
+
+// SetTimeEncoding sets the encoding Time.Value uses for every Time.  Connections set it for their dialect
+// before running benchmarks.
+func SetTimeEncoding(encoding TimeEncoding) {
+	timeEncoding = encoding
+}
+
 // Time overloads time.Time so we can use the same type in our models for CreatedTime and ModifiedTime
 // and use it in multiple database drivers.
 type Time struct {
 	time.Time
 }
 
+// ParseTime parses s with the first of the accepted layouts that matches.
+func ParseTime(s string) (Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			return Time{t}, nil
+		}
+	}
+	return ZeroTime, errors.Errorf("%q is not a supported time", s)
+}
+
 // Scan implements the Scanner interface.
 func (me *Time) Scan(value interface{}) error {
 	var err error
@@ -23,24 +73,65 @@ func (me *Time) Scan(value interface{}) error {
 		me.Time = v
 
 	case string:
-		if me.Time, err = time.ParseInLocation("2006-01-02 15:04:05", v, time.UTC); err != nil {
-			err = errors.Go(err)
-		}
+		*me, err = ParseTime(v)
+
+	case []byte:
+		*me, err = ParseTime(string(v))
 
 	case int64:
-		me.Time = time.Unix(v, 0)
+		if timeEncoding == TimeUnixNano {
+			me.Time = time.Unix(0, v)
+		} else {
+			me.Time = time.Unix(v, 0)
+		}
 
 	default:
-		err = errors.Errorf("%T unsupported for SqliteTime", value)
+		err = errors.Errorf("%T unsupported for Time", value)
 	}
 	return err
 }
 
-// Value implements the driver Valuer interface.
-func (me *Time) Value() (driver.Value, error) {
-	return me.Time.Unix(), nil
+// Value implements the driver Valuer interface with the encoding set by SetTimeEncoding.
+func (me Time) Value() (driver.Value, error) {
+	switch timeEncoding {
+	case TimeText:
+		return me.Time.UTC().Format(TimeTextLayout), nil
+	case TimeUnix:
+		return me.Time.Unix(), nil
+	case TimeUnixNano:
+		return me.Time.UnixNano(), nil
+	default:
+		return me.Time, nil
+	}
 }
 
 func (*Time) GormDataType() string {
 	return "TIME"
 }
+
+// NullTime is a Time that may be NULL.
+type NullTime struct {
+	Time
+	Valid bool
+}
+
+// Scan implements the Scanner interface.
+func (me *NullTime) Scan(value interface{}) error {
+	if value == nil {
+		me.Time, me.Valid = ZeroTime, false
+		return nil
+	}
+	if err := me.Time.Scan(value); err != nil {
+		return err
+	}
+	me.Valid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (me NullTime) Value() (driver.Value, error) {
+	if !me.Valid {
+		return nil, nil
+	}
+	return me.Time.Value()
+}
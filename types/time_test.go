@@ -0,0 +1,211 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nofeaturesonlybugs/sqlhbenchmarks/types"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		Name   string
+		Value  string
+		Expect time.Time
+		Error  bool
+	}{
+		{
+			Name:   "rfc3339nano",
+			Value:  "2021-06-03T10:20:30.123456789Z",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 123456789, time.UTC),
+		},
+		{
+			Name:   "rfc3339nano offset",
+			Value:  "2021-06-03T12:20:30.5+02:00",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 500000000, time.UTC),
+		},
+		{
+			Name:   "rfc3339 whole seconds",
+			Value:  "2021-06-03T10:20:30Z",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			Name:   "space zone",
+			Value:  "2021-06-03 10:20:30.123-05:00",
+			Expect: time.Date(2021, 6, 3, 15, 20, 30, 123000000, time.UTC),
+		},
+		{
+			Name:   "go string",
+			Value:  "2021-06-03 10:20:30.000001 +0000 UTC",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 1000, time.UTC),
+		},
+		{
+			Name:   "fractional datetime",
+			Value:  "2021-06-03 10:20:30.123456789",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 123456789, time.UTC),
+		},
+		{
+			Name:   "text layout",
+			Value:  "2021-06-03 10:20:30.120000000",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 120000000, time.UTC),
+		},
+		{
+			Name:   "plain datetime",
+			Value:  "2021-06-03 10:20:30",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 0, time.UTC),
+		},
+		{
+			Name:   "t datetime",
+			Value:  "2021-06-03T10:20:30.25",
+			Expect: time.Date(2021, 6, 3, 10, 20, 30, 250000000, time.UTC),
+		},
+		{
+			Name:   "date",
+			Value:  "2021-06-03",
+			Expect: time.Date(2021, 6, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:  "empty",
+			Value: "",
+			Error: true,
+		},
+		{
+			Name:  "garbage",
+			Value: "yesterday",
+			Error: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			got, err := types.ParseTime(test.Value)
+			if test.Error {
+				if err == nil {
+					t.Fatalf("expected an error; parsed %v", got)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("parse failed with %v", err.Error())
+			}
+			if !got.Equal(test.Expect) {
+				t.Errorf("parsed %v; expected %v", got, test.Expect)
+			}
+		})
+	}
+}
+
+func TestTimeScan(t *testing.T) {
+	t.Cleanup(func() { types.SetTimeEncoding(types.TimeNative) })
+	expect := time.Date(2021, 6, 3, 10, 20, 30, 123456789, time.UTC)
+	tests := []struct {
+		Name     string
+		Encoding types.TimeEncoding
+		Value    interface{}
+		Expect   time.Time
+		Error    bool
+	}{
+		{Name: "time.Time", Value: expect, Expect: expect},
+		{Name: "string", Value: "2021-06-03T10:20:30.123456789Z", Expect: expect},
+		{Name: "[]byte", Value: []byte("2021-06-03 10:20:30.123456789"), Expect: expect},
+		{Name: "int64 seconds", Value: expect.Unix(), Expect: expect.Truncate(time.Second)},
+		{Name: "int64 nanoseconds", Encoding: types.TimeUnixNano, Value: expect.UnixNano(), Expect: expect},
+		{Name: "bad string", Value: "yesterday", Error: true},
+		{Name: "float64", Value: 1.5, Error: true},
+		{Name: "nil", Value: nil, Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			types.SetTimeEncoding(test.Encoding)
+			var got types.Time
+			err := got.Scan(test.Value)
+			if test.Error {
+				if err == nil {
+					t.Fatalf("expected an error; scanned %v", got)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("scan failed with %v", err.Error())
+			}
+			if !got.Equal(test.Expect) {
+				t.Errorf("scanned %v; expected %v", got, test.Expect)
+			}
+		})
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	t.Cleanup(func() { types.SetTimeEncoding(types.TimeNative) })
+	value := types.Time{Time: time.Date(2021, 6, 3, 10, 20, 30, 123456789, time.FixedZone("offset", -5*60*60))}
+	tests := []struct {
+		Name     string
+		Encoding types.TimeEncoding
+		// Precision is how much of value survives the round trip.
+		Precision time.Duration
+	}{
+		{"native", types.TimeNative, time.Nanosecond},
+		{"text", types.TimeText, time.Nanosecond},
+		{"unix", types.TimeUnix, time.Second},
+		{"unix nano", types.TimeUnixNano, time.Nanosecond},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			types.SetTimeEncoding(test.Encoding)
+			encoded, err := value.Value()
+			if err != nil {
+				t.Fatalf("value failed with %v", err.Error())
+			}
+			var got types.Time
+			if err = got.Scan(encoded); err != nil {
+				t.Fatalf("scan of %T %v failed with %v", encoded, encoded, err.Error())
+			}
+			if expect := value.Truncate(test.Precision); !got.Equal(expect) {
+				t.Errorf("round trip of %v is %v; expected %v", value, got, expect)
+			}
+			if keeps := got.Nanosecond() != 0; keeps != (test.Precision < time.Second) {
+				t.Errorf("sub-second precision kept is %v; expected %v", keeps, test.Precision < time.Second)
+			}
+		})
+	}
+}
+
+func TestNullTime(t *testing.T) {
+	expect := time.Date(2021, 6, 3, 10, 20, 30, 0, time.UTC)
+	tests := []struct {
+		Name   string
+		Value  interface{}
+		Valid  bool
+		Expect time.Time
+		Error  bool
+	}{
+		{Name: "nil", Value: nil},
+		{Name: "time.Time", Value: expect, Valid: true, Expect: expect},
+		{Name: "string", Value: "2021-06-03 10:20:30", Valid: true, Expect: expect},
+		{Name: "bad string", Value: "yesterday", Error: true},
+	}
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			// Start valid so a NULL is seen to reset it.
+			got := types.NullTime{Time: types.Time{Time: time.Now()}, Valid: true}
+			err := got.Scan(test.Value)
+			if test.Error {
+				if err == nil {
+					t.Fatalf("expected an error; scanned %v", got)
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("scan failed with %v", err.Error())
+			}
+			if got.Valid != test.Valid {
+				t.Fatalf("valid is %v; expected %v", got.Valid, test.Valid)
+			} else if !got.Time.Equal(test.Expect) {
+				t.Errorf("scanned %v; expected %v", got.Time, test.Expect)
+			}
+			//
+			value, err := got.Value()
+			if err != nil {
+				t.Fatalf("value failed with %v", err.Error())
+			} else if (value == nil) == test.Valid {
+				t.Errorf("value is %v; expected NULL to be %v", value, !test.Valid)
+			}
+		})
+	}
+}
@@ -1,16 +1,47 @@
 package types
 
 import (
+	"database/sql"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/nofeaturesonlybugs/set"
 	"github.com/nofeaturesonlybugs/sqlh/grammar"
 	"github.com/nofeaturesonlybugs/sqlh/model"
 )
 
-// Model table names.
-var AddressTableName = "sqlh_addresses"
+// Model table names; see SetTableSuffix.
+var (
+	AddressTableName       = "sqlh_addresses"
+	CustomerTableName      = "sqlh_customers"
+	VendorTableName        = "sqlh_vendors"
+	VendorContactTableName = "sqlh_vendor_contacts"
+	SaleTableName          = "sqlh_sales"
+	//
+	NullableAddressTableName = "sqlh_nullable_addresses"
+	KitchenSinkTableName     = "sqlh_kitchen_sinks"
+)
+
+// tableNames maps the base name of each model table to the variable holding its current name.
+var tableNames = map[string]*string{
+	"sqlh_addresses":       &AddressTableName,
+	"sqlh_customers":       &CustomerTableName,
+	"sqlh_vendors":         &VendorTableName,
+	"sqlh_vendor_contacts": &VendorContactTableName,
+	"sqlh_sales":           &SaleTableName,
+	//
+	"sqlh_nullable_addresses": &NullableAddressTableName,
+	"sqlh_kitchen_sinks":      &KitchenSinkTableName,
+}
+
+// SetTableSuffix sets every model table name to its base name followed by suffix.  Call it before
+// NewModels or opening a GORM connection since both capture the table names in effect at the time.
+func SetTableSuffix(suffix string) {
+	for base, name := range tableNames {
+		*name = base + suffix
+	}
+}
 
 // MockRows is the interface for mocking rows.
 type MockRows interface {
@@ -29,9 +60,12 @@ type TestModel interface {
 // NewMapper returns an appropriate *set.Mapper for the types in this package.
 func NewMapper() *set.Mapper {
 	rv := &set.Mapper{
-		TreatAsScalar: set.NewTypeList(Time{}),
-		Join:          "_",
-		Tags:          []string{"db", "json"},
+		TreatAsScalar: set.NewTypeList(
+			Time{}, NullTime{}, Decimal{}, UUID{}, JSON{}, []byte{}, pq.StringArray{}, CancelTrigger{},
+			sql.NullBool{}, sql.NullFloat64{}, sql.NullInt32{}, sql.NullInt64{}, sql.NullString{}, sql.NullTime{},
+		),
+		Join: "_",
+		Tags: []string{"db", "json"},
 	}
 	return rv
 }
@@ -67,8 +101,9 @@ func (me *Address) MockRows(n int) *sqlmock.Rows {
 		"pk", "created_tmz", "modified_tmz",
 		"street", "city", "state", "zip",
 	})
+	addresses := AddressRecords()
 	for k := 0; k < n; k++ {
-		j := AddressRecords[k%SizeAddressRecords]
+		j := addresses[k%len(addresses)]
 		rows.AddRow(
 			j.Id, j.CreatedTime, j.ModifiedTime,
 			j.Street, j.City, j.State, j.Zip,
@@ -109,9 +144,9 @@ func (me *Address) TableName() string {
 
 // SaleReport is a SELECT destination; it does not represent models.
 type SaleReport struct {
-	Id                 int    `json:"id" db:"pk"`
-	CreatedTime        string `json:"created_time" db:"created_tmz"`
-	ModifiedTime       string `json:"modified_time" db:"modified_tmz"`
+	Id                 int    `json:"id" db:"pk" gorm:"column:pk"`
+	CreatedTime        string `json:"created_time" db:"created_tmz" gorm:"column:created_tmz"`
+	ModifiedTime       string `json:"modified_time" db:"modified_tmz" gorm:"column:modified_tmz"`
 	Price              int    `json:"price" db:"price"`
 	Quantity           int    `json:"quantity" db:"quantity"`
 	Total              int    `json:"total" db:"total"`
@@ -134,8 +169,9 @@ func (me *SaleReport) MockRows(n int) *sqlmock.Rows {
 		"vendor_id", "vendor_name", "vendor_description",
 		"vendor_contact_id", "vendor_contact_first", "vendor_contact_last",
 	})
+	sales := SaleRecords()
 	for k := 0; k < n; k++ {
-		j := SaleRecords[k%SizeSaleRecords]
+		j := sales[k%len(sales)]
 		rows.AddRow(
 			j.Id, j.CreatedTime, j.ModifiedTime,
 			j.Price, j.Quantity, j.Total,
@@ -2,36 +2,45 @@ package types
 
 import (
 	"encoding/json"
+	"sync"
 
 	"github.com/nofeaturesonlybugs/sqlhbenchmarks/data"
 )
 
-// SaleRecords is initialized when importing the package.
-var SaleRecords []*SaleReport
-var SizeSaleRecords int
-
-// AddressRecords is initialized when importing the package.
-var AddressRecords []*Address
-var SizeAddressRecords int
+// records holds the datasets returned by SaleRecords and AddressRecords.  The built-in datasets are parsed
+// on first use so importing the package does not pay for parsing them.
+var records struct {
+	sync.Mutex
+	sales     []*SaleReport
+	addresses []*Address
+}
 
-func init() {
-	{
+// SaleRecords returns the records loaded by LoadFixtures or, when none were loaded, the records parsed from
+// data.JsonSales.
+func SaleRecords() []*SaleReport {
+	records.Lock()
+	defer records.Unlock()
+	if records.sales == nil {
 		dest := []*SaleReport{}
-		err := json.Unmarshal([]byte(data.JsonSales), &dest)
-		if err != nil {
-			panic("init sales json with " + err.Error())
+		if err := json.Unmarshal([]byte(data.JsonSales), &dest); err != nil {
+			panic("parse sales json with " + err.Error())
 		}
-		SaleRecords = dest
-		SizeSaleRecords = len(SaleRecords)
+		records.sales = dest
 	}
-	//
-	{
+	return records.sales
+}
+
+// AddressRecords returns the records loaded by LoadFixtures or, when none were loaded, the records parsed
+// from data.JsonAddresses.
+func AddressRecords() []*Address {
+	records.Lock()
+	defer records.Unlock()
+	if records.addresses == nil {
 		dest := []*Address{}
-		err := json.Unmarshal([]byte(data.JsonAddresses), &dest)
-		if err != nil {
-			panic("init addresses json with " + err.Error())
+		if err := json.Unmarshal([]byte(data.JsonAddresses), &dest); err != nil {
+			panic("parse addresses json with " + err.Error())
 		}
-		AddressRecords = dest
-		SizeAddressRecords = len(AddressRecords)
+		records.addresses = dest
 	}
+	return records.addresses
 }
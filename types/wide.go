@@ -0,0 +1,75 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+//go:generate go run ../internal/widegen -out wide_gen.go
+
+// Wide is implemented by the generated WideN types.
+type Wide interface {
+	MockRows
+	// Pointers returns the addresses of the fields in column order; used by hand written database/sql code.
+	Pointers() []interface{}
+}
+
+// WideType describes a generated WideN type.
+type WideType struct {
+	TableName *string
+	// New returns a *WideN.
+	New func() Wide
+	// NewSlice returns a *[]*WideN.
+	NewSlice func() interface{}
+}
+
+// WideTypes maps column counts to the generated WideN types.
+var WideTypes = map[int]WideType{}
+
+// WideKind returns the kind of column k in every WideN type.
+func WideKind(k int) reflect.Kind {
+	return [...]reflect.Kind{reflect.Int, reflect.String, reflect.Float64, reflect.Bool}[k%4]
+}
+
+// WideColumns returns the column names of a WideN type with n columns.
+func WideColumns(n int) []string {
+	rv := make([]string, n)
+	for k := range rv {
+		rv[k] = fmt.Sprintf("c%03d", k)
+	}
+	return rv
+}
+
+// WideValues returns the values of row in a table of n wide columns; the first column is unique per row.
+func WideValues(n int, row int) []interface{} {
+	rv := make([]interface{}, n)
+	for k := range rv {
+		switch WideKind(k) {
+		case reflect.Int:
+			rv[k] = int64(row*n + k + 1)
+		case reflect.String:
+			rv[k] = fmt.Sprintf("row %v column %v", row, k)
+		case reflect.Float64:
+			rv[k] = float64(row) + float64(k)/1000
+		case reflect.Bool:
+			rv[k] = (row+k)%2 == 0
+		}
+	}
+	return rv
+}
+
+// wideMockRows returns n mock rows of a WideN type with columns columns.
+func wideMockRows(columns int, n int) *sqlmock.Rows {
+	rows := sqlmock.NewRows(WideColumns(columns))
+	values := make([]driver.Value, columns)
+	for k := 0; k < n; k++ {
+		for j, v := range WideValues(columns, k) {
+			values[j] = v
+		}
+		rows.AddRow(values...)
+	}
+	return rows
+}
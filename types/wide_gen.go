@@ -0,0 +1,775 @@
+// Code generated by internal/widegen; DO NOT EDIT.
+
+package types
+
+import "github.com/DATA-DOG/go-sqlmock"
+
+// Wide table names; see SetTableSuffix.
+var (
+	Wide50TableName  = "sqlh_wide50"
+	Wide100TableName = "sqlh_wide100"
+	Wide200TableName = "sqlh_wide200"
+)
+
+func init() {
+	tableNames["sqlh_wide50"] = &Wide50TableName
+	WideTypes[50] = WideType{
+		TableName: &Wide50TableName,
+		New:       func() Wide { return &Wide50{} },
+		NewSlice:  func() interface{} { return &[]*Wide50{} },
+	}
+	tableNames["sqlh_wide100"] = &Wide100TableName
+	WideTypes[100] = WideType{
+		TableName: &Wide100TableName,
+		New:       func() Wide { return &Wide100{} },
+		NewSlice:  func() interface{} { return &[]*Wide100{} },
+	}
+	tableNames["sqlh_wide200"] = &Wide200TableName
+	WideTypes[200] = WideType{
+		TableName: &Wide200TableName,
+		New:       func() Wide { return &Wide200{} },
+		NewSlice:  func() interface{} { return &[]*Wide200{} },
+	}
+}
+
+// Wide50 is a SELECT destination with 50 columns.
+type Wide50 struct {
+	C000 int     `json:"c000" db:"c000"`
+	C001 string  `json:"c001" db:"c001"`
+	C002 float64 `json:"c002" db:"c002"`
+	C003 bool    `json:"c003" db:"c003"`
+	C004 int     `json:"c004" db:"c004"`
+	C005 string  `json:"c005" db:"c005"`
+	C006 float64 `json:"c006" db:"c006"`
+	C007 bool    `json:"c007" db:"c007"`
+	C008 int     `json:"c008" db:"c008"`
+	C009 string  `json:"c009" db:"c009"`
+	C010 float64 `json:"c010" db:"c010"`
+	C011 bool    `json:"c011" db:"c011"`
+	C012 int     `json:"c012" db:"c012"`
+	C013 string  `json:"c013" db:"c013"`
+	C014 float64 `json:"c014" db:"c014"`
+	C015 bool    `json:"c015" db:"c015"`
+	C016 int     `json:"c016" db:"c016"`
+	C017 string  `json:"c017" db:"c017"`
+	C018 float64 `json:"c018" db:"c018"`
+	C019 bool    `json:"c019" db:"c019"`
+	C020 int     `json:"c020" db:"c020"`
+	C021 string  `json:"c021" db:"c021"`
+	C022 float64 `json:"c022" db:"c022"`
+	C023 bool    `json:"c023" db:"c023"`
+	C024 int     `json:"c024" db:"c024"`
+	C025 string  `json:"c025" db:"c025"`
+	C026 float64 `json:"c026" db:"c026"`
+	C027 bool    `json:"c027" db:"c027"`
+	C028 int     `json:"c028" db:"c028"`
+	C029 string  `json:"c029" db:"c029"`
+	C030 float64 `json:"c030" db:"c030"`
+	C031 bool    `json:"c031" db:"c031"`
+	C032 int     `json:"c032" db:"c032"`
+	C033 string  `json:"c033" db:"c033"`
+	C034 float64 `json:"c034" db:"c034"`
+	C035 bool    `json:"c035" db:"c035"`
+	C036 int     `json:"c036" db:"c036"`
+	C037 string  `json:"c037" db:"c037"`
+	C038 float64 `json:"c038" db:"c038"`
+	C039 bool    `json:"c039" db:"c039"`
+	C040 int     `json:"c040" db:"c040"`
+	C041 string  `json:"c041" db:"c041"`
+	C042 float64 `json:"c042" db:"c042"`
+	C043 bool    `json:"c043" db:"c043"`
+	C044 int     `json:"c044" db:"c044"`
+	C045 string  `json:"c045" db:"c045"`
+	C046 float64 `json:"c046" db:"c046"`
+	C047 bool    `json:"c047" db:"c047"`
+	C048 int     `json:"c048" db:"c048"`
+	C049 string  `json:"c049" db:"c049"`
+}
+
+// Pointers returns the addresses of the fields in column order.
+func (me *Wide50) Pointers() []interface{} {
+	return []interface{}{
+		&me.C000,
+		&me.C001,
+		&me.C002,
+		&me.C003,
+		&me.C004,
+		&me.C005,
+		&me.C006,
+		&me.C007,
+		&me.C008,
+		&me.C009,
+		&me.C010,
+		&me.C011,
+		&me.C012,
+		&me.C013,
+		&me.C014,
+		&me.C015,
+		&me.C016,
+		&me.C017,
+		&me.C018,
+		&me.C019,
+		&me.C020,
+		&me.C021,
+		&me.C022,
+		&me.C023,
+		&me.C024,
+		&me.C025,
+		&me.C026,
+		&me.C027,
+		&me.C028,
+		&me.C029,
+		&me.C030,
+		&me.C031,
+		&me.C032,
+		&me.C033,
+		&me.C034,
+		&me.C035,
+		&me.C036,
+		&me.C037,
+		&me.C038,
+		&me.C039,
+		&me.C040,
+		&me.C041,
+		&me.C042,
+		&me.C043,
+		&me.C044,
+		&me.C045,
+		&me.C046,
+		&me.C047,
+		&me.C048,
+		&me.C049,
+	}
+}
+
+func (me *Wide50) MockRows(n int) *sqlmock.Rows {
+	return wideMockRows(50, n)
+}
+
+// Wide100 is a SELECT destination with 100 columns.
+type Wide100 struct {
+	C000 int     `json:"c000" db:"c000"`
+	C001 string  `json:"c001" db:"c001"`
+	C002 float64 `json:"c002" db:"c002"`
+	C003 bool    `json:"c003" db:"c003"`
+	C004 int     `json:"c004" db:"c004"`
+	C005 string  `json:"c005" db:"c005"`
+	C006 float64 `json:"c006" db:"c006"`
+	C007 bool    `json:"c007" db:"c007"`
+	C008 int     `json:"c008" db:"c008"`
+	C009 string  `json:"c009" db:"c009"`
+	C010 float64 `json:"c010" db:"c010"`
+	C011 bool    `json:"c011" db:"c011"`
+	C012 int     `json:"c012" db:"c012"`
+	C013 string  `json:"c013" db:"c013"`
+	C014 float64 `json:"c014" db:"c014"`
+	C015 bool    `json:"c015" db:"c015"`
+	C016 int     `json:"c016" db:"c016"`
+	C017 string  `json:"c017" db:"c017"`
+	C018 float64 `json:"c018" db:"c018"`
+	C019 bool    `json:"c019" db:"c019"`
+	C020 int     `json:"c020" db:"c020"`
+	C021 string  `json:"c021" db:"c021"`
+	C022 float64 `json:"c022" db:"c022"`
+	C023 bool    `json:"c023" db:"c023"`
+	C024 int     `json:"c024" db:"c024"`
+	C025 string  `json:"c025" db:"c025"`
+	C026 float64 `json:"c026" db:"c026"`
+	C027 bool    `json:"c027" db:"c027"`
+	C028 int     `json:"c028" db:"c028"`
+	C029 string  `json:"c029" db:"c029"`
+	C030 float64 `json:"c030" db:"c030"`
+	C031 bool    `json:"c031" db:"c031"`
+	C032 int     `json:"c032" db:"c032"`
+	C033 string  `json:"c033" db:"c033"`
+	C034 float64 `json:"c034" db:"c034"`
+	C035 bool    `json:"c035" db:"c035"`
+	C036 int     `json:"c036" db:"c036"`
+	C037 string  `json:"c037" db:"c037"`
+	C038 float64 `json:"c038" db:"c038"`
+	C039 bool    `json:"c039" db:"c039"`
+	C040 int     `json:"c040" db:"c040"`
+	C041 string  `json:"c041" db:"c041"`
+	C042 float64 `json:"c042" db:"c042"`
+	C043 bool    `json:"c043" db:"c043"`
+	C044 int     `json:"c044" db:"c044"`
+	C045 string  `json:"c045" db:"c045"`
+	C046 float64 `json:"c046" db:"c046"`
+	C047 bool    `json:"c047" db:"c047"`
+	C048 int     `json:"c048" db:"c048"`
+	C049 string  `json:"c049" db:"c049"`
+	C050 float64 `json:"c050" db:"c050"`
+	C051 bool    `json:"c051" db:"c051"`
+	C052 int     `json:"c052" db:"c052"`
+	C053 string  `json:"c053" db:"c053"`
+	C054 float64 `json:"c054" db:"c054"`
+	C055 bool    `json:"c055" db:"c055"`
+	C056 int     `json:"c056" db:"c056"`
+	C057 string  `json:"c057" db:"c057"`
+	C058 float64 `json:"c058" db:"c058"`
+	C059 bool    `json:"c059" db:"c059"`
+	C060 int     `json:"c060" db:"c060"`
+	C061 string  `json:"c061" db:"c061"`
+	C062 float64 `json:"c062" db:"c062"`
+	C063 bool    `json:"c063" db:"c063"`
+	C064 int     `json:"c064" db:"c064"`
+	C065 string  `json:"c065" db:"c065"`
+	C066 float64 `json:"c066" db:"c066"`
+	C067 bool    `json:"c067" db:"c067"`
+	C068 int     `json:"c068" db:"c068"`
+	C069 string  `json:"c069" db:"c069"`
+	C070 float64 `json:"c070" db:"c070"`
+	C071 bool    `json:"c071" db:"c071"`
+	C072 int     `json:"c072" db:"c072"`
+	C073 string  `json:"c073" db:"c073"`
+	C074 float64 `json:"c074" db:"c074"`
+	C075 bool    `json:"c075" db:"c075"`
+	C076 int     `json:"c076" db:"c076"`
+	C077 string  `json:"c077" db:"c077"`
+	C078 float64 `json:"c078" db:"c078"`
+	C079 bool    `json:"c079" db:"c079"`
+	C080 int     `json:"c080" db:"c080"`
+	C081 string  `json:"c081" db:"c081"`
+	C082 float64 `json:"c082" db:"c082"`
+	C083 bool    `json:"c083" db:"c083"`
+	C084 int     `json:"c084" db:"c084"`
+	C085 string  `json:"c085" db:"c085"`
+	C086 float64 `json:"c086" db:"c086"`
+	C087 bool    `json:"c087" db:"c087"`
+	C088 int     `json:"c088" db:"c088"`
+	C089 string  `json:"c089" db:"c089"`
+	C090 float64 `json:"c090" db:"c090"`
+	C091 bool    `json:"c091" db:"c091"`
+	C092 int     `json:"c092" db:"c092"`
+	C093 string  `json:"c093" db:"c093"`
+	C094 float64 `json:"c094" db:"c094"`
+	C095 bool    `json:"c095" db:"c095"`
+	C096 int     `json:"c096" db:"c096"`
+	C097 string  `json:"c097" db:"c097"`
+	C098 float64 `json:"c098" db:"c098"`
+	C099 bool    `json:"c099" db:"c099"`
+}
+
+// Pointers returns the addresses of the fields in column order.
+func (me *Wide100) Pointers() []interface{} {
+	return []interface{}{
+		&me.C000,
+		&me.C001,
+		&me.C002,
+		&me.C003,
+		&me.C004,
+		&me.C005,
+		&me.C006,
+		&me.C007,
+		&me.C008,
+		&me.C009,
+		&me.C010,
+		&me.C011,
+		&me.C012,
+		&me.C013,
+		&me.C014,
+		&me.C015,
+		&me.C016,
+		&me.C017,
+		&me.C018,
+		&me.C019,
+		&me.C020,
+		&me.C021,
+		&me.C022,
+		&me.C023,
+		&me.C024,
+		&me.C025,
+		&me.C026,
+		&me.C027,
+		&me.C028,
+		&me.C029,
+		&me.C030,
+		&me.C031,
+		&me.C032,
+		&me.C033,
+		&me.C034,
+		&me.C035,
+		&me.C036,
+		&me.C037,
+		&me.C038,
+		&me.C039,
+		&me.C040,
+		&me.C041,
+		&me.C042,
+		&me.C043,
+		&me.C044,
+		&me.C045,
+		&me.C046,
+		&me.C047,
+		&me.C048,
+		&me.C049,
+		&me.C050,
+		&me.C051,
+		&me.C052,
+		&me.C053,
+		&me.C054,
+		&me.C055,
+		&me.C056,
+		&me.C057,
+		&me.C058,
+		&me.C059,
+		&me.C060,
+		&me.C061,
+		&me.C062,
+		&me.C063,
+		&me.C064,
+		&me.C065,
+		&me.C066,
+		&me.C067,
+		&me.C068,
+		&me.C069,
+		&me.C070,
+		&me.C071,
+		&me.C072,
+		&me.C073,
+		&me.C074,
+		&me.C075,
+		&me.C076,
+		&me.C077,
+		&me.C078,
+		&me.C079,
+		&me.C080,
+		&me.C081,
+		&me.C082,
+		&me.C083,
+		&me.C084,
+		&me.C085,
+		&me.C086,
+		&me.C087,
+		&me.C088,
+		&me.C089,
+		&me.C090,
+		&me.C091,
+		&me.C092,
+		&me.C093,
+		&me.C094,
+		&me.C095,
+		&me.C096,
+		&me.C097,
+		&me.C098,
+		&me.C099,
+	}
+}
+
+func (me *Wide100) MockRows(n int) *sqlmock.Rows {
+	return wideMockRows(100, n)
+}
+
+// Wide200 is a SELECT destination with 200 columns.
+type Wide200 struct {
+	C000 int     `json:"c000" db:"c000"`
+	C001 string  `json:"c001" db:"c001"`
+	C002 float64 `json:"c002" db:"c002"`
+	C003 bool    `json:"c003" db:"c003"`
+	C004 int     `json:"c004" db:"c004"`
+	C005 string  `json:"c005" db:"c005"`
+	C006 float64 `json:"c006" db:"c006"`
+	C007 bool    `json:"c007" db:"c007"`
+	C008 int     `json:"c008" db:"c008"`
+	C009 string  `json:"c009" db:"c009"`
+	C010 float64 `json:"c010" db:"c010"`
+	C011 bool    `json:"c011" db:"c011"`
+	C012 int     `json:"c012" db:"c012"`
+	C013 string  `json:"c013" db:"c013"`
+	C014 float64 `json:"c014" db:"c014"`
+	C015 bool    `json:"c015" db:"c015"`
+	C016 int     `json:"c016" db:"c016"`
+	C017 string  `json:"c017" db:"c017"`
+	C018 float64 `json:"c018" db:"c018"`
+	C019 bool    `json:"c019" db:"c019"`
+	C020 int     `json:"c020" db:"c020"`
+	C021 string  `json:"c021" db:"c021"`
+	C022 float64 `json:"c022" db:"c022"`
+	C023 bool    `json:"c023" db:"c023"`
+	C024 int     `json:"c024" db:"c024"`
+	C025 string  `json:"c025" db:"c025"`
+	C026 float64 `json:"c026" db:"c026"`
+	C027 bool    `json:"c027" db:"c027"`
+	C028 int     `json:"c028" db:"c028"`
+	C029 string  `json:"c029" db:"c029"`
+	C030 float64 `json:"c030" db:"c030"`
+	C031 bool    `json:"c031" db:"c031"`
+	C032 int     `json:"c032" db:"c032"`
+	C033 string  `json:"c033" db:"c033"`
+	C034 float64 `json:"c034" db:"c034"`
+	C035 bool    `json:"c035" db:"c035"`
+	C036 int     `json:"c036" db:"c036"`
+	C037 string  `json:"c037" db:"c037"`
+	C038 float64 `json:"c038" db:"c038"`
+	C039 bool    `json:"c039" db:"c039"`
+	C040 int     `json:"c040" db:"c040"`
+	C041 string  `json:"c041" db:"c041"`
+	C042 float64 `json:"c042" db:"c042"`
+	C043 bool    `json:"c043" db:"c043"`
+	C044 int     `json:"c044" db:"c044"`
+	C045 string  `json:"c045" db:"c045"`
+	C046 float64 `json:"c046" db:"c046"`
+	C047 bool    `json:"c047" db:"c047"`
+	C048 int     `json:"c048" db:"c048"`
+	C049 string  `json:"c049" db:"c049"`
+	C050 float64 `json:"c050" db:"c050"`
+	C051 bool    `json:"c051" db:"c051"`
+	C052 int     `json:"c052" db:"c052"`
+	C053 string  `json:"c053" db:"c053"`
+	C054 float64 `json:"c054" db:"c054"`
+	C055 bool    `json:"c055" db:"c055"`
+	C056 int     `json:"c056" db:"c056"`
+	C057 string  `json:"c057" db:"c057"`
+	C058 float64 `json:"c058" db:"c058"`
+	C059 bool    `json:"c059" db:"c059"`
+	C060 int     `json:"c060" db:"c060"`
+	C061 string  `json:"c061" db:"c061"`
+	C062 float64 `json:"c062" db:"c062"`
+	C063 bool    `json:"c063" db:"c063"`
+	C064 int     `json:"c064" db:"c064"`
+	C065 string  `json:"c065" db:"c065"`
+	C066 float64 `json:"c066" db:"c066"`
+	C067 bool    `json:"c067" db:"c067"`
+	C068 int     `json:"c068" db:"c068"`
+	C069 string  `json:"c069" db:"c069"`
+	C070 float64 `json:"c070" db:"c070"`
+	C071 bool    `json:"c071" db:"c071"`
+	C072 int     `json:"c072" db:"c072"`
+	C073 string  `json:"c073" db:"c073"`
+	C074 float64 `json:"c074" db:"c074"`
+	C075 bool    `json:"c075" db:"c075"`
+	C076 int     `json:"c076" db:"c076"`
+	C077 string  `json:"c077" db:"c077"`
+	C078 float64 `json:"c078" db:"c078"`
+	C079 bool    `json:"c079" db:"c079"`
+	C080 int     `json:"c080" db:"c080"`
+	C081 string  `json:"c081" db:"c081"`
+	C082 float64 `json:"c082" db:"c082"`
+	C083 bool    `json:"c083" db:"c083"`
+	C084 int     `json:"c084" db:"c084"`
+	C085 string  `json:"c085" db:"c085"`
+	C086 float64 `json:"c086" db:"c086"`
+	C087 bool    `json:"c087" db:"c087"`
+	C088 int     `json:"c088" db:"c088"`
+	C089 string  `json:"c089" db:"c089"`
+	C090 float64 `json:"c090" db:"c090"`
+	C091 bool    `json:"c091" db:"c091"`
+	C092 int     `json:"c092" db:"c092"`
+	C093 string  `json:"c093" db:"c093"`
+	C094 float64 `json:"c094" db:"c094"`
+	C095 bool    `json:"c095" db:"c095"`
+	C096 int     `json:"c096" db:"c096"`
+	C097 string  `json:"c097" db:"c097"`
+	C098 float64 `json:"c098" db:"c098"`
+	C099 bool    `json:"c099" db:"c099"`
+	C100 int     `json:"c100" db:"c100"`
+	C101 string  `json:"c101" db:"c101"`
+	C102 float64 `json:"c102" db:"c102"`
+	C103 bool    `json:"c103" db:"c103"`
+	C104 int     `json:"c104" db:"c104"`
+	C105 string  `json:"c105" db:"c105"`
+	C106 float64 `json:"c106" db:"c106"`
+	C107 bool    `json:"c107" db:"c107"`
+	C108 int     `json:"c108" db:"c108"`
+	C109 string  `json:"c109" db:"c109"`
+	C110 float64 `json:"c110" db:"c110"`
+	C111 bool    `json:"c111" db:"c111"`
+	C112 int     `json:"c112" db:"c112"`
+	C113 string  `json:"c113" db:"c113"`
+	C114 float64 `json:"c114" db:"c114"`
+	C115 bool    `json:"c115" db:"c115"`
+	C116 int     `json:"c116" db:"c116"`
+	C117 string  `json:"c117" db:"c117"`
+	C118 float64 `json:"c118" db:"c118"`
+	C119 bool    `json:"c119" db:"c119"`
+	C120 int     `json:"c120" db:"c120"`
+	C121 string  `json:"c121" db:"c121"`
+	C122 float64 `json:"c122" db:"c122"`
+	C123 bool    `json:"c123" db:"c123"`
+	C124 int     `json:"c124" db:"c124"`
+	C125 string  `json:"c125" db:"c125"`
+	C126 float64 `json:"c126" db:"c126"`
+	C127 bool    `json:"c127" db:"c127"`
+	C128 int     `json:"c128" db:"c128"`
+	C129 string  `json:"c129" db:"c129"`
+	C130 float64 `json:"c130" db:"c130"`
+	C131 bool    `json:"c131" db:"c131"`
+	C132 int     `json:"c132" db:"c132"`
+	C133 string  `json:"c133" db:"c133"`
+	C134 float64 `json:"c134" db:"c134"`
+	C135 bool    `json:"c135" db:"c135"`
+	C136 int     `json:"c136" db:"c136"`
+	C137 string  `json:"c137" db:"c137"`
+	C138 float64 `json:"c138" db:"c138"`
+	C139 bool    `json:"c139" db:"c139"`
+	C140 int     `json:"c140" db:"c140"`
+	C141 string  `json:"c141" db:"c141"`
+	C142 float64 `json:"c142" db:"c142"`
+	C143 bool    `json:"c143" db:"c143"`
+	C144 int     `json:"c144" db:"c144"`
+	C145 string  `json:"c145" db:"c145"`
+	C146 float64 `json:"c146" db:"c146"`
+	C147 bool    `json:"c147" db:"c147"`
+	C148 int     `json:"c148" db:"c148"`
+	C149 string  `json:"c149" db:"c149"`
+	C150 float64 `json:"c150" db:"c150"`
+	C151 bool    `json:"c151" db:"c151"`
+	C152 int     `json:"c152" db:"c152"`
+	C153 string  `json:"c153" db:"c153"`
+	C154 float64 `json:"c154" db:"c154"`
+	C155 bool    `json:"c155" db:"c155"`
+	C156 int     `json:"c156" db:"c156"`
+	C157 string  `json:"c157" db:"c157"`
+	C158 float64 `json:"c158" db:"c158"`
+	C159 bool    `json:"c159" db:"c159"`
+	C160 int     `json:"c160" db:"c160"`
+	C161 string  `json:"c161" db:"c161"`
+	C162 float64 `json:"c162" db:"c162"`
+	C163 bool    `json:"c163" db:"c163"`
+	C164 int     `json:"c164" db:"c164"`
+	C165 string  `json:"c165" db:"c165"`
+	C166 float64 `json:"c166" db:"c166"`
+	C167 bool    `json:"c167" db:"c167"`
+	C168 int     `json:"c168" db:"c168"`
+	C169 string  `json:"c169" db:"c169"`
+	C170 float64 `json:"c170" db:"c170"`
+	C171 bool    `json:"c171" db:"c171"`
+	C172 int     `json:"c172" db:"c172"`
+	C173 string  `json:"c173" db:"c173"`
+	C174 float64 `json:"c174" db:"c174"`
+	C175 bool    `json:"c175" db:"c175"`
+	C176 int     `json:"c176" db:"c176"`
+	C177 string  `json:"c177" db:"c177"`
+	C178 float64 `json:"c178" db:"c178"`
+	C179 bool    `json:"c179" db:"c179"`
+	C180 int     `json:"c180" db:"c180"`
+	C181 string  `json:"c181" db:"c181"`
+	C182 float64 `json:"c182" db:"c182"`
+	C183 bool    `json:"c183" db:"c183"`
+	C184 int     `json:"c184" db:"c184"`
+	C185 string  `json:"c185" db:"c185"`
+	C186 float64 `json:"c186" db:"c186"`
+	C187 bool    `json:"c187" db:"c187"`
+	C188 int     `json:"c188" db:"c188"`
+	C189 string  `json:"c189" db:"c189"`
+	C190 float64 `json:"c190" db:"c190"`
+	C191 bool    `json:"c191" db:"c191"`
+	C192 int     `json:"c192" db:"c192"`
+	C193 string  `json:"c193" db:"c193"`
+	C194 float64 `json:"c194" db:"c194"`
+	C195 bool    `json:"c195" db:"c195"`
+	C196 int     `json:"c196" db:"c196"`
+	C197 string  `json:"c197" db:"c197"`
+	C198 float64 `json:"c198" db:"c198"`
+	C199 bool    `json:"c199" db:"c199"`
+}
+
+// Pointers returns the addresses of the fields in column order.
+func (me *Wide200) Pointers() []interface{} {
+	return []interface{}{
+		&me.C000,
+		&me.C001,
+		&me.C002,
+		&me.C003,
+		&me.C004,
+		&me.C005,
+		&me.C006,
+		&me.C007,
+		&me.C008,
+		&me.C009,
+		&me.C010,
+		&me.C011,
+		&me.C012,
+		&me.C013,
+		&me.C014,
+		&me.C015,
+		&me.C016,
+		&me.C017,
+		&me.C018,
+		&me.C019,
+		&me.C020,
+		&me.C021,
+		&me.C022,
+		&me.C023,
+		&me.C024,
+		&me.C025,
+		&me.C026,
+		&me.C027,
+		&me.C028,
+		&me.C029,
+		&me.C030,
+		&me.C031,
+		&me.C032,
+		&me.C033,
+		&me.C034,
+		&me.C035,
+		&me.C036,
+		&me.C037,
+		&me.C038,
+		&me.C039,
+		&me.C040,
+		&me.C041,
+		&me.C042,
+		&me.C043,
+		&me.C044,
+		&me.C045,
+		&me.C046,
+		&me.C047,
+		&me.C048,
+		&me.C049,
+		&me.C050,
+		&me.C051,
+		&me.C052,
+		&me.C053,
+		&me.C054,
+		&me.C055,
+		&me.C056,
+		&me.C057,
+		&me.C058,
+		&me.C059,
+		&me.C060,
+		&me.C061,
+		&me.C062,
+		&me.C063,
+		&me.C064,
+		&me.C065,
+		&me.C066,
+		&me.C067,
+		&me.C068,
+		&me.C069,
+		&me.C070,
+		&me.C071,
+		&me.C072,
+		&me.C073,
+		&me.C074,
+		&me.C075,
+		&me.C076,
+		&me.C077,
+		&me.C078,
+		&me.C079,
+		&me.C080,
+		&me.C081,
+		&me.C082,
+		&me.C083,
+		&me.C084,
+		&me.C085,
+		&me.C086,
+		&me.C087,
+		&me.C088,
+		&me.C089,
+		&me.C090,
+		&me.C091,
+		&me.C092,
+		&me.C093,
+		&me.C094,
+		&me.C095,
+		&me.C096,
+		&me.C097,
+		&me.C098,
+		&me.C099,
+		&me.C100,
+		&me.C101,
+		&me.C102,
+		&me.C103,
+		&me.C104,
+		&me.C105,
+		&me.C106,
+		&me.C107,
+		&me.C108,
+		&me.C109,
+		&me.C110,
+		&me.C111,
+		&me.C112,
+		&me.C113,
+		&me.C114,
+		&me.C115,
+		&me.C116,
+		&me.C117,
+		&me.C118,
+		&me.C119,
+		&me.C120,
+		&me.C121,
+		&me.C122,
+		&me.C123,
+		&me.C124,
+		&me.C125,
+		&me.C126,
+		&me.C127,
+		&me.C128,
+		&me.C129,
+		&me.C130,
+		&me.C131,
+		&me.C132,
+		&me.C133,
+		&me.C134,
+		&me.C135,
+		&me.C136,
+		&me.C137,
+		&me.C138,
+		&me.C139,
+		&me.C140,
+		&me.C141,
+		&me.C142,
+		&me.C143,
+		&me.C144,
+		&me.C145,
+		&me.C146,
+		&me.C147,
+		&me.C148,
+		&me.C149,
+		&me.C150,
+		&me.C151,
+		&me.C152,
+		&me.C153,
+		&me.C154,
+		&me.C155,
+		&me.C156,
+		&me.C157,
+		&me.C158,
+		&me.C159,
+		&me.C160,
+		&me.C161,
+		&me.C162,
+		&me.C163,
+		&me.C164,
+		&me.C165,
+		&me.C166,
+		&me.C167,
+		&me.C168,
+		&me.C169,
+		&me.C170,
+		&me.C171,
+		&me.C172,
+		&me.C173,
+		&me.C174,
+		&me.C175,
+		&me.C176,
+		&me.C177,
+		&me.C178,
+		&me.C179,
+		&me.C180,
+		&me.C181,
+		&me.C182,
+		&me.C183,
+		&me.C184,
+		&me.C185,
+		&me.C186,
+		&me.C187,
+		&me.C188,
+		&me.C189,
+		&me.C190,
+		&me.C191,
+		&me.C192,
+		&me.C193,
+		&me.C194,
+		&me.C195,
+		&me.C196,
+		&me.C197,
+		&me.C198,
+		&me.C199,
+	}
+}
+
+func (me *Wide200) MockRows(n int) *sqlmock.Rows {
+	return wideMockRows(200, n)
+}